@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// runCheck reads the provider environment variables, signs in and performs a few
+// read-only API calls, printing a connectivity and permission report to out.
+// It returns false if any check failed.
+func runCheck(ctx context.Context, out io.Writer) bool {
+	ok := true
+	report := func(passed bool, format string, args ...any) {
+		status := "  OK  "
+		if !passed {
+			status = " FAIL "
+			ok = false
+		}
+		fmt.Fprintf(out, "[%s] %s\n", status, fmt.Sprintf(format, args...))
+	}
+
+	// Trailing slashes would double the slash in front of the API paths
+	endpoint := strings.TrimRight(os.Getenv("STORAGEGRID_ENDPOINT"), "/")
+	s3Endpoint := strings.TrimRight(os.Getenv("STORAGEGRID_S3_ENDPOINT"), "/")
+	accountID := os.Getenv("STORAGEGRID_ACCOUNTID")
+	username := os.Getenv("STORAGEGRID_USERNAME")
+	password := os.Getenv("STORAGEGRID_PASSWORD")
+	token := os.Getenv("STORAGEGRID_TOKEN")
+
+	fmt.Fprintln(out, "StorageGrid provider connectivity check")
+	fmt.Fprintln(out)
+
+	// Configuration
+	required := map[string]string{"STORAGEGRID_ENDPOINT": endpoint}
+	if token == "" {
+		required["STORAGEGRID_ACCOUNTID"] = accountID
+		required["STORAGEGRID_USERNAME"] = username
+		required["STORAGEGRID_PASSWORD"] = password
+	}
+	var missing []string
+	for name, value := range required {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+	slices.Sort(missing)
+	if len(missing) > 0 {
+		report(false, "Configuration: missing environment variables: %s", strings.Join(missing, ", "))
+		return false
+	}
+	if token != "" {
+		report(true, "Configuration: management endpoint %s, pre-issued token", endpoint)
+	} else {
+		report(true, "Configuration: management endpoint %s, account %s, user %s", endpoint, accountID, username)
+	}
+	if s3Endpoint == "" {
+		fmt.Fprintln(out, "         STORAGEGRID_S3_ENDPOINT is not set; S3 based resources (lifecycle configuration) only work if the management endpoint uses the default port 9443")
+	}
+
+	opts := utils.ClientOptions{UserAgent: "terraform-provider-storagegrid/" + version}
+	opts.InsecureSkipVerify, _ = strconv.ParseBool(os.Getenv("STORAGEGRID_INSECURE_SKIP_VERIFY"))
+	if caCertFile := os.Getenv("STORAGEGRID_CA_CERT_FILE"); caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			report(false, "Configuration: reading STORAGEGRID_CA_CERT_FILE failed: %s", err)
+			return false
+		}
+		opts.CACertPEM = string(pem)
+	}
+
+	// Connectivity, API version negotiation and authentication
+	opts.Token = token
+	client, err := utils.NewClient(ctx, &endpoint, &s3Endpoint, &accountID, &username, &password, opts)
+	if err != nil {
+		report(false, "Connectivity and authentication: %s", err)
+		return false
+	}
+	report(true, "Connectivity: supported API versions %v (provider uses v%d)", client.OfferedAPIVersions(), client.APIVersion())
+	if token != "" {
+		report(true, "Authentication: using pre-issued token")
+	} else {
+		report(true, "Authentication: signed in to account %s as %s", accountID, username)
+	}
+
+	// Permissions
+	buckets, err := client.ListS3Buckets(ctx)
+	if err != nil {
+		report(false, "Permissions: listing buckets failed: %s", err)
+	} else {
+		report(true, "Permissions: listed %d buckets", len(buckets))
+	}
+
+	fmt.Fprintln(out)
+	if ok {
+		fmt.Fprintln(out, "All checks passed.")
+	} else {
+		fmt.Fprintln(out, "Some checks failed.")
+	}
+
+	return ok
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		signIn      int
+		containers  int
+		wantOK      bool
+		wantReport  []string
+		notInReport []string
+	}{
+		{
+			name:       "success",
+			signIn:     http.StatusOK,
+			containers: http.StatusOK,
+			wantOK:     true,
+			wantReport: []string{
+				"[  OK  ] Connectivity: supported API versions [3 4] (provider uses v4)",
+				"[  OK  ] Authentication: signed in to account 12345 as admin",
+				"[  OK  ] Permissions: listed 2 buckets",
+				"All checks passed.",
+			},
+		},
+		{
+			name:   "sign-in failure",
+			signIn: http.StatusUnauthorized,
+			wantReport: []string{
+				"[ FAIL ] Connectivity and authentication: failed to sign in",
+			},
+			notInReport: []string{"Permissions:", "All checks passed."},
+		},
+		{
+			name:       "container list failure",
+			signIn:     http.StatusOK,
+			containers: http.StatusForbidden,
+			wantReport: []string{
+				"[  OK  ] Authentication: signed in to account 12345 as admin",
+				"[ FAIL ] Permissions: listing buckets failed:",
+				"Some checks failed.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var versionRequests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/versions":
+					versionRequests.Add(1)
+					_, _ = w.Write([]byte(`{"data": [3, 4]}`))
+				case "/api/v4/authorize":
+					w.WriteHeader(tt.signIn)
+					if tt.signIn != http.StatusOK {
+						_, _ = w.Write([]byte(`{"code": 401, "message": {"text": "invalid credentials"}}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"data": "test-token"}`))
+				case "/api/v4/org/containers":
+					w.WriteHeader(tt.containers)
+					if tt.containers != http.StatusOK {
+						_, _ = w.Write([]byte(`{"code": 403, "message": {"text": "access denied"}}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"data": [{"name": "logs"}, {"name": "backups"}]}`))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			defer server.Close()
+
+			t.Setenv("STORAGEGRID_ENDPOINT", server.URL+"/")
+			t.Setenv("STORAGEGRID_S3_ENDPOINT", server.URL)
+			t.Setenv("STORAGEGRID_ACCOUNTID", "12345")
+			t.Setenv("STORAGEGRID_USERNAME", "admin")
+			t.Setenv("STORAGEGRID_PASSWORD", "secret")
+			t.Setenv("STORAGEGRID_TOKEN", "")
+			t.Setenv("STORAGEGRID_INSECURE_SKIP_VERIFY", "")
+			t.Setenv("STORAGEGRID_CA_CERT_FILE", "")
+
+			var out bytes.Buffer
+			if ok := runCheck(context.Background(), &out); ok != tt.wantOK {
+				t.Fatalf("runCheck = %t, want %t, report:\n%s", ok, tt.wantOK, out.String())
+			}
+			report := out.String()
+			for _, want := range tt.wantReport {
+				if !strings.Contains(report, want) {
+					t.Errorf("report does not contain %q:\n%s", want, report)
+				}
+			}
+			for _, unwanted := range tt.notInReport {
+				if strings.Contains(report, unwanted) {
+					t.Errorf("report contains %q:\n%s", unwanted, report)
+				}
+			}
+			if n := versionRequests.Load(); n != 1 {
+				t.Errorf("GET /api/versions was requested %d times, want once", n)
+			}
+		})
+	}
+}
@@ -7,6 +7,11 @@ data "storagegrid_group" "bar" {
   group_name = "bar-readonly"
 }
 
+# Look up a group by the ID StorageGrid generated for it
+data "storagegrid_group" "by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
 # Output group information
 output "foo_group_unique_name" {
   value = data.storagegrid_group.foo.unique_name
@@ -19,3 +24,7 @@ output "foo_group_policies" {
 output "bar_group_display_name" {
   value = data.storagegrid_group.bar.display_name
 }
+
+output "by_id_group_name" {
+  value = data.storagegrid_group.by_id.group_name
+}
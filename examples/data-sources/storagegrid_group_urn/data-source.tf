@@ -0,0 +1,11 @@
+# Resolve the URN of an existing group by name
+data "storagegrid_group_urn" "developers" {
+  group_name = "developers"
+}
+
+# Use the URN as a principal when composing a bucket policy
+output "developers_principal" {
+  value = {
+    SGWS = data.storagegrid_group_urn.developers.group_urn
+  }
+}
@@ -0,0 +1,13 @@
+# List all groups of the tenant account
+data "storagegrid_groups" "all" {}
+
+# List the federated groups whose name starts with "storage-"
+data "storagegrid_groups" "storage_teams" {
+  name_prefix = "storage-"
+  federated   = true
+}
+
+# Groups with root access, for an access review
+output "root_access_groups" {
+  value = [for group in data.storagegrid_groups.all.groups : group.unique_name if group.management.root_access]
+}
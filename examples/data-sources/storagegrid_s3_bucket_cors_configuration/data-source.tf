@@ -0,0 +1,9 @@
+# Look up the CORS configuration of the assets bucket
+data "storagegrid_s3_bucket_cors_configuration" "assets" {
+  bucket_name = "assets-bucket"
+}
+
+# Output the origins allowed by the CORS rules
+output "assets_allowed_origins" {
+  value = flatten(data.storagegrid_s3_bucket_cors_configuration.assets.cors_rule[*].allowed_origins)
+}
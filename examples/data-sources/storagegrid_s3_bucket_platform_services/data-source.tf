@@ -0,0 +1,16 @@
+# Look up platform services status for the foo bucket
+data "storagegrid_s3_bucket_platform_services" "foo" {
+  bucket_name = "foo-bucket"
+}
+
+# Output endpoints that reported errors
+output "foo_failing_endpoints" {
+  value = [
+    for endpoint in data.storagegrid_s3_bucket_platform_services.foo.endpoints : endpoint.urn
+    if endpoint.last_error != null
+  ]
+}
+
+output "foo_platform_services_healthy" {
+  value = !data.storagegrid_s3_bucket_platform_services.foo.has_errors
+}
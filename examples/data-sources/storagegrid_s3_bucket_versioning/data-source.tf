@@ -9,18 +9,10 @@ data "storagegrid_s3_bucket_versioning" "bar" {
 }
 
 # Output versioning information
-output "foo_versioning_enabled" {
-  value = data.storagegrid_s3_bucket_versioning.foo.versioning_enabled
-}
-
-output "foo_versioning_suspended" {
-  value = data.storagegrid_s3_bucket_versioning.foo.versioning_suspended
-}
-
 output "foo_versioning_status" {
-  value = local.foo_versioning_status
+  value = data.storagegrid_s3_bucket_versioning.foo.status
 }
 
-output "bar_versioning_id" {
-  value = data.storagegrid_s3_bucket_versioning.bar.id
+output "bar_versioning_enabled" {
+  value = data.storagegrid_s3_bucket_versioning.bar.status == "Enabled"
 }
@@ -0,0 +1,15 @@
+# Fail the plan early if the S3 endpoint cannot be reached
+data "storagegrid_s3_endpoint_check" "this" {}
+
+# Record the result without failing, e.g. for monitoring outputs
+data "storagegrid_s3_endpoint_check" "soft" {
+  fail_on_error = false
+}
+
+output "s3_endpoint_latency_ms" {
+  value = data.storagegrid_s3_endpoint_check.this.latency_ms
+}
+
+output "s3_endpoint_error" {
+  value = data.storagegrid_s3_endpoint_check.soft.error
+}
@@ -0,0 +1,7 @@
+# Only enable object lock on the bucket when the grid supports it
+data "storagegrid_s3_object_lock_settings" "grid" {}
+
+resource "storagegrid_s3_bucket" "records" {
+  bucket_name         = "records-bucket"
+  object_lock_enabled = data.storagegrid_s3_object_lock_settings.grid.enabled
+}
@@ -0,0 +1,12 @@
+# Fetch the usage of a Swift container
+data "storagegrid_swift_container" "archive" {
+  name = "archive"
+}
+
+output "archive_object_count" {
+  value = data.storagegrid_swift_container.archive.object_count
+}
+
+output "archive_data_bytes" {
+  value = data.storagegrid_swift_container.archive.data_bytes
+}
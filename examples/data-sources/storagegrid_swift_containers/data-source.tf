@@ -0,0 +1,9 @@
+# List all Swift containers of the tenant with their usage
+data "storagegrid_swift_containers" "all" {}
+
+output "swift_container_usage" {
+  value = {
+    for container in data.storagegrid_swift_containers.all.containers :
+    container.name => container.data_bytes
+  }
+}
@@ -0,0 +1,11 @@
+# Requires grid administrator credentials in the grid block of the provider configuration
+
+# List the tenant accounts whose name starts with "team-"
+data "storagegrid_tenant_accounts" "teams" {
+  name_prefix = "team-"
+}
+
+# Tenant accounts without a storage quota
+output "unlimited_tenants" {
+  value = [for account in data.storagegrid_tenant_accounts.teams.accounts : account.name if account.quota_object_bytes == null]
+}
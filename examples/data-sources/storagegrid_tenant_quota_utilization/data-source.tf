@@ -0,0 +1,18 @@
+# Look up the current quota utilization of the tenant
+data "storagegrid_tenant_quota_utilization" "current" {}
+
+# Refuse to create more buckets when the tenant is above 90% of its quota
+resource "storagegrid_s3_bucket" "data" {
+  bucket_name = "my-data-bucket"
+
+  lifecycle {
+    precondition {
+      condition     = coalesce(data.storagegrid_tenant_quota_utilization.current.utilization_percent, 0) < 90
+      error_message = "The tenant is using more than 90% of its storage quota."
+    }
+  }
+}
+
+output "tenant_utilization_percent" {
+  value = data.storagegrid_tenant_quota_utilization.current.utilization_percent
+}
@@ -0,0 +1,13 @@
+# List all users of the tenant account
+data "storagegrid_users" "all" {}
+
+# List the users of the developers group whose name starts with "svc-"
+data "storagegrid_users" "service_accounts" {
+  name_prefix = "svc-"
+  member_of   = "developers"
+}
+
+# Enabled service accounts, e.g. to drive access key rotation
+output "service_account_ids" {
+  value = [for user in data.storagegrid_users.service_accounts.users : user.id if !user.disable]
+}
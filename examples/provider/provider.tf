@@ -16,6 +16,60 @@ provider "storagegrid" {
   accountid = "12345678901234567890"
   username  = "admin"
   password  = "password"
+
+  # Optional: grid administrator credentials for grid-level resources
+  grid {
+    endpoint = "https://admin.storagegrid.example.com"
+    username = "root"
+    password = "grid-password"
+  }
+
+  # Optional: region of buckets that do not set one
+  default_region = "eu-west-3"
+
+  # Optional: reject access keys that expire more than 90 days in the future
+  max_access_key_lifetime_days = 90
+
+  # Optional: send at most 10 management API requests per second, with bursts of up to 20
+  requests_per_second = 10
+  burst               = 20
+
+  # Optional: keep at most 8 API requests in flight, regardless of -parallelism
+  max_concurrent_requests = 8
+
+  # Optional: keep up to 32 idle connections per endpoint open for 5 minutes
+  max_idle_conns    = 32
+  idle_conn_timeout = "5m"
+
+  # Optional: label the temporary S3 access key and reuse it across runs for up to a day
+  temporary_access_key {
+    lifetime            = "24h"
+    display_name_prefix = "terraform-"
+    reuse               = true
+  }
+
+  # Optional: headers set on every API request, e.g. for routing by an API gateway
+  extra_headers = {
+    "X-Gateway-Route" = "storage-team"
+  }
+
+  # Optional: cache the bucket list for 1 minute instead of 5, "session" to keep it for the
+  # whole plan or apply, or "0s" to disable the cache
+  bucket_cache_ttl = "1m"
+
+  # Optional: also load the delete status of buckets with the cached bucket list
+  bucket_list_include = ["deleteObjectStatus"]
+
+  # Optional: keep retrying for up to a few minutes while a grid is in a maintenance window
+  retry_max_attempts = 8
+  retry_max_delay    = "2m"
+  retry_status_codes = [429, 502, 503, 504]
+
+  # Optional: per-request timeouts, each defaults to 60s
+  timeouts {
+    read   = "30s"
+    delete = "15m" # Deleting buckets that hold many objects can take a while
+  }
 }
 
 # Alternative: Using environment variables
@@ -24,6 +78,24 @@ provider "storagegrid" {
 # export STORAGEGRID_ACCOUNTID="12345678901234567890"
 # export STORAGEGRID_USERNAME="admin"
 # export STORAGEGRID_PASSWORD="password"
+# export STORAGEGRID_GRID_USERNAME="root"  # Optional: grid administrator, see the grid block
+# export STORAGEGRID_GRID_PASSWORD="grid-password"
+# export STORAGEGRID_S3_ACCESS_KEY="..." # Optional: static S3 credentials used instead of a temporary access key
+# export STORAGEGRID_S3_SECRET_KEY="..."
+# export STORAGEGRID_CREDENTIALS_FILE="$HOME/.storagegrid/credentials" # Optional: read accountid, username and password from a file
+# export STORAGEGRID_CREDENTIAL_PROCESS="vault-storagegrid-creds prod" # Optional: or from the output of a command
+# export STORAGEGRID_AUTH_MODE="sso" # Optional: sign in through the identity provider of a grid with single sign-on
+# export STORAGEGRID_SAML_RESPONSE_PROCESS="adfs-saml-login" # Prints the SAML response for the sign-in URL passed as last argument
+# export STORAGEGRID_TOKEN="..." # Optional: pre-issued bearer token used instead of accountid, username and password
+# export STORAGEGRID_CACHE_TOKEN="true" # Optional: reuse the bearer token of the last sign-in until it expires
+# export STORAGEGRID_AUDIT_LOG_PATH="/var/log/terraform/storagegrid-audit.jsonl" # Optional: record every API request
+# export STORAGEGRID_LOG_CURL_COMMANDS="true" # Optional: log management API requests as curl commands at DEBUG level
+# export STORAGEGRID_READ_ONLY="true" # Optional: refuse all changes, e.g. for drift detection
+# export STORAGEGRID_SKIP_CREDENTIALS_VALIDATION="true" # Optional: do not check the credentials while configuring the provider
+# export STORAGEGRID_CA_CERT_FILE="/etc/ssl/certs/internal-ca.pem" # Optional: trust an internal CA
+# export STORAGEGRID_S3_CA_CERT_FILE="/etc/ssl/certs/s3-ca.pem" # Optional: separate CA for the S3 endpoint
+# export STORAGEGRID_S3_TLS_SERVER_NAME="s3.storagegrid.example.com" # Optional: server name of the S3 certificate when the S3 endpoint is an IP address
+# export STORAGEGRID_S3_HOST_HEADER="s3.storagegrid.example.com" # Optional: Host header of S3 requests
 
 provider "storagegrid" {
   # Configuration will be read from environment variables
@@ -1,6 +1,7 @@
-# Create a StorageGrid group with S3 and management permissions
+# Create a StorageGrid group with S3 and management permissions, protected from deletion
 resource "storagegrid_group" "admin" {
-  group_name = "admin-group"
+  group_name          = "admin-group"
+  deletion_protection = true
 
   policies {
     management = {
@@ -61,3 +62,32 @@ resource "storagegrid_group" "readonly" {
     s3 = file("${path.module}/policies/readonly-policy.json")
   }
 }
+
+# Only reconcile selected management permissions; root_access is managed by
+# the security team outside Terraform and left untouched
+resource "storagegrid_group" "operators" {
+  group_name         = "operators"
+  manage_permissions = ["manage_endpoints", "view_all_containers"]
+
+  policies = {
+    management = {
+      manage_endpoints    = true
+      view_all_containers = true
+    }
+    s3 = file("${path.module}/policies/readonly-policy.json")
+  }
+}
+
+# Manage the policies of a group synced from the identity source of the tenant; import
+# existing federated groups by their unique name, e.g. "federated-group/storage-admins"
+resource "storagegrid_group" "storage_admins" {
+  group_name = "storage-admins"
+  federated  = true
+
+  policies = {
+    management = {
+      manage_all_containers = true
+    }
+    s3 = file("${path.module}/policies/readonly-policy.json")
+  }
+}
@@ -10,3 +10,58 @@ resource "storagegrid_s3_bucket" "compliance" {
   region              = "us-west-2"
   object_lock_enabled = true
 }
+
+# Create an S3 bucket that retains objects for 7 years in compliance mode
+resource "storagegrid_s3_bucket" "audit" {
+  bucket_name         = "audit-bucket"
+  object_lock_enabled = true
+
+  object_lock_default_retention {
+    mode  = "compliance"
+    years = 7
+  }
+}
+
+# Fail bucket creation when the tenant is already using 90% of its quota
+resource "storagegrid_s3_bucket" "pipeline" {
+  bucket_name             = "pipeline-bucket"
+  quota_warning_threshold = 90
+  enforce_quota_headroom  = true
+}
+
+# Refuse to destroy the bucket while it still contains objects
+resource "storagegrid_s3_bucket" "records" {
+  bucket_name   = "records-bucket"
+  require_empty = true
+}
+
+# Refuse to destroy or replace the bucket until deletion_protection is disabled
+resource "storagegrid_s3_bucket" "ledger" {
+  bucket_name         = "ledger-bucket"
+  deletion_protection = true
+}
+
+# Wait up to 2 hours on destroy for objects deleted in the background by StorageGrid
+resource "storagegrid_s3_bucket" "archive" {
+  bucket_name            = "archive-bucket"
+  delete_objects_timeout = "2h"
+
+  timeouts {
+    delete = "3h"
+  }
+}
+
+# Create a bucket with a unique name, such as ci-20261014113830a1b2c3
+resource "storagegrid_s3_bucket" "ci" {
+  bucket_name_prefix = "ci-"
+}
+
+# Tag the bucket for chargeback
+resource "storagegrid_s3_bucket" "reports" {
+  bucket_name = "reports-bucket"
+
+  tags = {
+    cost-center = "1234"
+    team        = "analytics"
+  }
+}
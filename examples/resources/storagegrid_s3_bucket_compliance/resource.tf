@@ -0,0 +1,6 @@
+# Retain the objects of a legacy compliant bucket for one year and delete them afterwards
+resource "storagegrid_s3_bucket_compliance" "records" {
+  bucket_name              = "legacy-records-bucket"
+  retention_period_minutes = 525600
+  auto_delete              = true
+}
@@ -0,0 +1,5 @@
+# Require strong consistency across all sites for the ledger bucket
+resource "storagegrid_s3_bucket_consistency" "ledger" {
+  bucket_name = storagegrid_s3_bucket.ledger.bucket_name
+  consistency = "strong-global"
+}
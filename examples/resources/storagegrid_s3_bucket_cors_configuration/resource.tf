@@ -0,0 +1,18 @@
+# Allow a web application to read and upload objects of the assets bucket
+resource "storagegrid_s3_bucket_cors_configuration" "assets" {
+  bucket_name = storagegrid_s3_bucket.assets.bucket_name
+
+  cors_rule {
+    id              = "web-app"
+    allowed_origins = ["https://app.example.com"]
+    allowed_methods = ["GET", "HEAD", "PUT"]
+    allowed_headers = ["*"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+
+  cors_rule {
+    allowed_origins = ["*"]
+    allowed_methods = ["GET"]
+  }
+}
@@ -0,0 +1,11 @@
+# Replicate the objects of the data bucket to the bucket of the same name on the
+# grid at the other end of a grid federation connection
+resource "storagegrid_s3_bucket_cross_grid_replication" "data" {
+  bucket_name = storagegrid_s3_bucket.data.bucket_name
+
+  rule {
+    grid_connection_id = "b4e9f7a2-0c1d-4e5f-8a9b-1c2d3e4f5a6b"
+  }
+
+  depends_on = [storagegrid_s3_bucket_versioning.data]
+}
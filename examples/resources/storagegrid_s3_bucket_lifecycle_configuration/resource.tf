@@ -34,6 +34,10 @@ resource "storagegrid_s3_bucket_lifecycle_configuration" "data" {
     noncurrent_version_expiration {
       noncurrent_days = 1
     }
+
+    abort_incomplete_multipart_upload {
+      days_after_initiation = 1
+    }
   }
 }
 
@@ -50,7 +54,8 @@ resource "storagegrid_s3_bucket_lifecycle_configuration" "archive" {
     }
 
     noncurrent_version_expiration {
-      noncurrent_days = 90
+      noncurrent_days           = 90
+      newer_noncurrent_versions = 3
     }
   }
 }
@@ -72,3 +77,63 @@ resource "storagegrid_s3_bucket_lifecycle_configuration" "simple" {
     }
   }
 }
+
+# Expire large archived reports by combining a prefix, tags and a size bound
+resource "storagegrid_s3_bucket_lifecycle_configuration" "reports" {
+  bucket_name = storagegrid_s3_bucket.reports.bucket_name
+
+  rule {
+    id     = "large-archived-reports"
+    status = "Enabled"
+
+    filter {
+      and {
+        prefix = "reports/"
+        tags = {
+          class = "archive"
+        }
+        object_size_greater_than = 104857600
+      }
+    }
+
+    expiration {
+      days = 365
+    }
+  }
+}
+
+# Expire large noncurrent versions a week after they are replaced
+resource "storagegrid_s3_bucket_lifecycle_configuration" "large_noncurrent" {
+  bucket_name = storagegrid_s3_bucket.media.bucket_name
+
+  rule {
+    id     = "large-noncurrent-versions"
+    status = "Enabled"
+
+    filter {
+      object_size_greater_than = 104857600
+    }
+
+    noncurrent_version_expiration {
+      noncurrent_days = 7
+    }
+  }
+}
+
+# Clean up delete markers left behind once all noncurrent versions have expired
+resource "storagegrid_s3_bucket_lifecycle_configuration" "versioned" {
+  bucket_name = storagegrid_s3_bucket.versioned.bucket_name
+
+  rule {
+    id     = "delete-marker-cleanup"
+    status = "Enabled"
+
+    expiration {
+      expired_object_delete_marker = true
+    }
+
+    noncurrent_version_expiration {
+      noncurrent_days = 30
+    }
+  }
+}
@@ -0,0 +1,17 @@
+# Notify an SNS endpoint of uploaded CSV files and of deleted objects
+resource "storagegrid_s3_bucket_notification" "data" {
+  bucket_name = storagegrid_s3_bucket.data.bucket_name
+
+  topic {
+    id            = "csv-uploads"
+    topic_urn     = "arn:aws:sns:us-east-1:123456789012:uploads"
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "incoming/"
+    filter_suffix = ".csv"
+  }
+
+  topic {
+    topic_urn = "arn:aws:sns:us-east-1:123456789012:deletes"
+    events    = ["s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated"]
+  }
+}
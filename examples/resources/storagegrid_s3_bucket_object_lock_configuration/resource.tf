@@ -3,9 +3,8 @@ resource "storagegrid_s3_bucket_object_lock_configuration" "compliance" {
   bucket_name = storagegrid_s3_bucket.compliance.bucket_name
 
   default_retention_setting {
-    mode  = "compliance"
-    days  = 30
-    years = 0
+    mode = "compliance"
+    days = 30
   }
 }
 
@@ -15,7 +14,6 @@ resource "storagegrid_s3_bucket_object_lock_configuration" "audit" {
 
   default_retention_setting {
     mode  = "governance"
-    days  = 0
     years = 7
   }
 }
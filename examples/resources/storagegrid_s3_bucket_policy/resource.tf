@@ -0,0 +1,30 @@
+# Allow a federated group to read the objects of a bucket, and deny every request
+# that is not made over HTTPS
+resource "storagegrid_s3_bucket_policy" "data" {
+  bucket_name = storagegrid_s3_bucket.data.bucket_name
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid       = "ReadOnlyForAnalysts"
+        Effect    = "Allow"
+        Principal = { SGWS = "arn:aws:iam::12345678901234567890:federated-group/analysts" }
+        Action    = ["s3:GetObject", "s3:ListBucket"]
+        Resource = [
+          "arn:aws:s3:::${storagegrid_s3_bucket.data.bucket_name}",
+          "arn:aws:s3:::${storagegrid_s3_bucket.data.bucket_name}/*",
+        ]
+      },
+      {
+        Sid       = "DenyInsecureTransport"
+        Effect    = "Deny"
+        Principal = "*"
+        Action    = "s3:*"
+        Resource  = "arn:aws:s3:::${storagegrid_s3_bucket.data.bucket_name}/*"
+        Condition = {
+          Bool = { "aws:SecureTransport" = "false" }
+        }
+      },
+    ]
+  })
+}
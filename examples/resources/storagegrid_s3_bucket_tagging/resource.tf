@@ -0,0 +1,9 @@
+# Manage the tags of a bucket separately from the bucket itself
+resource "storagegrid_s3_bucket_tagging" "data" {
+  bucket_name = storagegrid_s3_bucket.data.bucket_name
+
+  tags = {
+    cost-center = "1234"
+    environment = "production"
+  }
+}
@@ -0,0 +1,20 @@
+# Retain a report in compliance mode and place it under legal hold
+resource "storagegrid_s3_object_lock_settings" "report" {
+  bucket_name       = storagegrid_s3_bucket.compliance.bucket_name
+  key               = "reports/2026/annual.pdf"
+  retention_mode    = "compliance"
+  retain_until_date = "2033-01-01T00:00:00Z"
+  legal_hold        = true
+}
+
+# Retain a specific object version in governance mode. With
+# bypass_governance_retention the retention may be shortened or removed,
+# including when the resource is destroyed.
+resource "storagegrid_s3_object_lock_settings" "audit_log" {
+  bucket_name                 = storagegrid_s3_bucket.audit.bucket_name
+  key                         = "logs/audit.log"
+  version_id                  = "MUQ4MTk3NDMtMzM5Mi0xMUYwLUE1QTYtNjVBMDM1QkIzMTJG"
+  retention_mode              = "governance"
+  retain_until_date           = "2027-06-30T00:00:00Z"
+  bypass_governance_retention = true
+}
@@ -0,0 +1,9 @@
+data "storagegrid_tenant_accounts" "analytics" {
+  name_prefix = "analytics"
+}
+
+resource "storagegrid_tenant_account_profile" "analytics" {
+  account_id  = data.storagegrid_tenant_accounts.analytics.accounts[0].id
+  name        = "analytics"
+  description = "Tenant of the analytics team"
+}
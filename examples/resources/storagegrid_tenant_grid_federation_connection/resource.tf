@@ -0,0 +1,17 @@
+data "storagegrid_tenant_accounts" "analytics" {
+  name_prefix = "analytics"
+}
+
+# Let the tenant replicate its buckets to the other grid of the federation
+resource "storagegrid_tenant_grid_federation_connection" "analytics" {
+  account_id         = data.storagegrid_tenant_accounts.analytics.accounts[0].id
+  grid_connection_id = var.grid_connection_id
+}
+
+resource "storagegrid_s3_bucket_cross_grid_replication" "reports" {
+  bucket_name = "reports"
+
+  rule {
+    grid_connection_id = storagegrid_tenant_grid_federation_connection.analytics.grid_connection_id
+  }
+}
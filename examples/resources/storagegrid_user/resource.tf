@@ -24,3 +24,12 @@ resource "storagegrid_user" "disabled" {
   member_of = []
   disable   = true
 }
+
+# Set the password with a write-only argument (Terraform 1.11 or later), so it is not
+# stored in the state; bump password_wo_version to set a new password
+resource "storagegrid_user" "ci" {
+  user_name           = "ci"
+  member_of           = ["developers"]
+  password_wo         = var.ci_password
+  password_wo_version = 1
+}
@@ -0,0 +1,18 @@
+resource "storagegrid_user" "ci" {
+  user_name = "ci"
+  member_of = ["developers"]
+}
+
+# Rotate the password of the user every quarter, without replacing the user
+resource "time_rotating" "ci_password" {
+  rotation_months = 3
+}
+
+resource "storagegrid_user_password" "ci" {
+  user_name = storagegrid_user.ci.user_name
+  password  = var.ci_password # Write-only, never stored in the state
+
+  rotation_trigger = {
+    rotated_at = time_rotating.ci_password.id
+  }
+}
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,8 +19,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &AccessKeysResource{}
-	_ resource.ResourceWithConfigure = &AccessKeysResource{}
+	_ resource.Resource               = &AccessKeysResource{}
+	_ resource.ResourceWithConfigure  = &AccessKeysResource{}
+	_ resource.ResourceWithModifyPlan = &AccessKeysResource{}
 )
 
 // NewAccessKeysResource creates a new instance of the AccessKeysResource.
@@ -113,18 +115,90 @@ func (r *AccessKeysResource) Configure(_ context.Context, req resource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
+}
+
+// ModifyPlan enforces the provider's max_access_key_lifetime_days policy on keys that are
+// about to be created or replaced.
+func (r *AccessKeysResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.MaxAccessKeyLifetime == 0 {
+		return
+	}
+
+	var plan AccessKeysResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Existing keys are only checked when a change forces a new key to be created
+	if !req.State.Raw.IsNull() {
+		var state AccessKeysResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Expires.Equal(state.Expires) && plan.CreatedDate.Equal(state.CreatedDate) && plan.UserName.Equal(state.UserName) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validateAccessKeyLifetime(plan.Expires, r.client.MaxAccessKeyLifetime, time.Now())...)
+}
+
+// validateAccessKeyLifetime checks that expires is set and no further than maxLifetime from now.
+func validateAccessKeyLifetime(expires types.String, maxLifetime time.Duration, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	maxDays := int64(maxLifetime / (24 * time.Hour))
+
+	if expires.IsUnknown() {
+		return diags
+	}
+	if expires.IsNull() {
+		diags.AddAttributeError(
+			path.Root("expires"),
+			"Access Key Expiration Required",
+			fmt.Sprintf("The provider limits access keys to a lifetime of %d days, so expires must be set.", maxDays),
+		)
+		return diags
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expires.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("expires"),
+			"Invalid Access Key Expiration",
+			fmt.Sprintf("Could not parse expires as an ISO 8601 timestamp: %s", err.Error()),
+		)
+		return diags
+	}
+
+	if latest := now.Add(maxLifetime); expiresAt.After(latest) {
+		diags.AddAttributeError(
+			path.Root("expires"),
+			"Access Key Lifetime Exceeds Policy",
+			fmt.Sprintf("The access key expires at %s, but the provider limits access keys to a lifetime of %d days (until %s).",
+				expiresAt.Format(time.RFC3339), maxDays, latest.Format(time.RFC3339)),
+		)
+	}
+
+	return diags
 }
 
 func (r *AccessKeysResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan AccessKeysResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -134,9 +208,9 @@ func (r *AccessKeysResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Step 1: Get the User ID from the provided User Name.
 	userName := plan.UserName.ValueString()
-	apiUser, err := r.client.GetUser("user/" + userName)
+	apiUser, err := r.client.GetUser(ctx, "user/"+userName)
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
+		if utils.IsNotFound(err) {
 			resp.Diagnostics.AddError("User Not Found", fmt.Sprintf("Could not find user with name: '%s'", userName))
 			return
 		}
@@ -152,7 +226,7 @@ func (r *AccessKeysResource) Create(ctx context.Context, req resource.CreateRequ
 		payload.Expires = &expires
 	}
 
-	createdKey, err := r.client.CreateS3AccessKey(userID, payload)
+	createdKey, err := r.client.CreateS3AccessKey(ctx, userID, payload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating S3 Access Key", "Could not create S3 access key: "+err.Error())
 		return
@@ -193,7 +267,7 @@ func (r *AccessKeysResource) Read(ctx context.Context, req resource.ReadRequest,
 		// This can happen if the resource was imported without the user_id being resolved.
 		// We can attempt to resolve it now.
 		userName := state.UserName.ValueString()
-		apiUser, err := r.client.GetUser("user/" + userName)
+		apiUser, err := r.client.GetUser(ctx, "user/"+userName)
 		if err != nil {
 			resp.Diagnostics.AddWarning("User Not Found on Read", fmt.Sprintf("Cannot find user '%s' to refresh access key state. If the user was deleted, the key is also gone.", userName))
 			resp.State.RemoveResource(ctx)
@@ -203,9 +277,9 @@ func (r *AccessKeysResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.UserID = types.StringValue(userID)
 	}
 
-	apiKeys, err := r.client.GetS3AccessKeys(userID)
+	apiKeys, err := r.client.GetS3AccessKeys(ctx, userID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if utils.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -244,6 +318,11 @@ func (r *AccessKeysResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *AccessKeysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state AccessKeysResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -252,9 +331,9 @@ func (r *AccessKeysResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete uses the UserID and KeyID stored in the state.
-	err := r.client.DeleteS3AccessKey(state.UserID.ValueString(), state.ID.ValueString())
+	err := r.client.DeleteS3AccessKey(ctx, state.UserID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
+		if utils.IsNotFound(err) {
 			return // Already gone, successful deletion.
 		}
 		resp.Diagnostics.AddError(
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateAccessKeyLifetime(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxLifetime := 90 * 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		expires types.String
+		wantErr bool
+	}{
+		{name: "within lifetime", expires: types.StringValue("2026-03-01T00:00:00.000Z")},
+		{name: "at the limit", expires: types.StringValue("2026-04-01T00:00:00Z")},
+		{name: "beyond lifetime", expires: types.StringValue("2026-04-02T00:00:00.000Z"), wantErr: true},
+		{name: "no expiration", expires: types.StringNull(), wantErr: true},
+		{name: "unparseable", expires: types.StringValue("next year"), wantErr: true},
+		{name: "unknown", expires: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateAccessKeyLifetime(tt.expires, maxLifetime, now)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("validateAccessKeyLifetime() errors = %v, want error %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
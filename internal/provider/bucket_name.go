@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bucketNameSuffixLength is the length of the suffix appended to bucket_name_prefix: a
+// UTC timestamp to the second followed by 6 random hex digits.
+const bucketNameSuffixLength = 20
+
+// bucketNamePattern matches bucket names made of labels separated by single periods,
+// each starting and ending with a lowercase letter or number and otherwise holding
+// lowercase letters, numbers and hyphens.
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+
+// bucketNamePrefixPattern matches the start of a bucket name, which may end with the
+// period or hyphen that separates it from the suffix.
+var bucketNamePrefixPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*[.-]?$`)
+
+// bucketNamePrefixValidators enforce the bucket naming rules on a prefix, so that every
+// name generated from it is valid.
+func bucketNamePrefixValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, 63-bucketNameSuffixLength),
+		stringvalidator.RegexMatches(bucketNamePrefixPattern, "must consist of lowercase letters, numbers, hyphens and periods, "+
+			"start with a letter or number, and not have a period next to another period or a hyphen"),
+	}
+}
+
+// uniqueBucketName returns prefix followed by a suffix of bucketNameSuffixLength lowercase
+// letters and numbers, unique to the second and 24 random bits.
+func uniqueBucketName(prefix string) (string, error) {
+	random := make([]byte, 3)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return prefix + time.Now().UTC().Format("20060102150405") + hex.EncodeToString(random), nil
+}
+
+// keepGeneratedBucketName plans the bucket name of state for buckets named from
+// bucket_name_prefix while the prefix does not change. Without it, the computed name
+// would be unknown in every change and replace the bucket.
+func keepGeneratedBucketName() planmodifier.String {
+	return keepGeneratedBucketNameModifier{}
+}
+
+type keepGeneratedBucketNameModifier struct{}
+
+func (m keepGeneratedBucketNameModifier) Description(_ context.Context) string {
+	return "Keeps the generated bucket name while bucket_name_prefix does not change."
+}
+
+func (m keepGeneratedBucketNameModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m keepGeneratedBucketNameModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var planPrefix, statePrefix types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("bucket_name_prefix"), &planPrefix)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("bucket_name_prefix"), &statePrefix)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planPrefix.Equal(statePrefix) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// bucketNameValidators enforce the S3 bucket naming rules of StorageGrid at plan time.
+func bucketNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(3, 63),
+		stringvalidator.RegexMatches(bucketNamePattern, "must consist of lowercase letters, numbers, hyphens and periods, "+
+			"start and end with a letter or number, and not have a period next to another period or a hyphen"),
+		notIPAddressValidator{},
+	}
+}
+
+// notIPAddressValidator rejects values formatted as an IPv4 address, which S3 does not
+// allow as a bucket name.
+type notIPAddressValidator struct{}
+
+func (v notIPAddressValidator) Description(_ context.Context) string {
+	return "value must not be formatted as an IP address"
+}
+
+func (v notIPAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notIPAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if addr, err := netip.ParseAddr(req.ConfigValue.ValueString()); err == nil && addr.Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Bucket Name",
+			fmt.Sprintf("Bucket names must not be formatted as an IP address, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBucketNameValidators(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "valid name", value: types.StringValue("my-data-bucket")},
+		{name: "valid name with periods", value: types.StringValue("logs.example.com")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "too short", value: types.StringValue("ab"), wantError: true},
+		{name: "too long", value: types.StringValue("a1234567890123456789012345678901234567890123456789012345678901bc"), wantError: true},
+		{name: "uppercase", value: types.StringValue("MyBucket"), wantError: true},
+		{name: "underscore", value: types.StringValue("my_bucket"), wantError: true},
+		{name: "leading hyphen", value: types.StringValue("-bucket"), wantError: true},
+		{name: "trailing period", value: types.StringValue("bucket."), wantError: true},
+		{name: "adjacent periods", value: types.StringValue("my..bucket"), wantError: true},
+		{name: "period next to hyphen", value: types.StringValue("my.-bucket"), wantError: true},
+		{name: "IP address", value: types.StringValue("192.168.5.4"), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("bucket_name"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			for _, v := range bucketNameValidators() {
+				v.ValidateString(ctx, req, resp)
+			}
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %t, want %t: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestUniqueBucketName(t *testing.T) {
+	prefix := strings.Repeat("a", 63-bucketNameSuffixLength-1) + "-"
+
+	first, err := uniqueBucketName(prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := uniqueBucketName(prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(first, prefix) || len(first) != 63 {
+		t.Fatalf("uniqueBucketName(%q) = %q, want the prefix and a length of 63", prefix, first)
+	}
+	if !bucketNamePattern.MatchString(first) {
+		t.Fatalf("uniqueBucketName(%q) = %q, which is not a valid bucket name", prefix, first)
+	}
+	if first == second {
+		t.Fatalf("uniqueBucketName(%q) returned %q twice", prefix, first)
+	}
+}
+
+func TestBucketNamePrefixValidators(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		value     string
+		wantError bool
+	}{
+		{value: "test-"},
+		{value: "logs.ci."},
+		{value: "tmp"},
+		{value: "", wantError: true},
+		{value: "-test", wantError: true},
+		{value: "test_", wantError: true},
+		{value: "test.-", wantError: true},
+		{value: strings.Repeat("a", 64-bucketNameSuffixLength), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("bucket_name_prefix"),
+				ConfigValue: types.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+
+			for _, v := range bucketNamePrefixValidators() {
+				v.ValidateString(ctx, req, resp)
+			}
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %t, want %t: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
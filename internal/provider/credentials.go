@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// tenantCredentials holds the tenant credentials read from a credentials file or
+// returned by a credential process.
+type tenantCredentials struct {
+	AccountID string `json:"accountid"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+}
+
+// readCredentialsFile reads tenant credentials from a JSON or INI file.
+func readCredentialsFile(name string) (tenantCredentials, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return tenantCredentials{}, err
+	}
+	return parseCredentials(data)
+}
+
+// runCredentialProcess runs command and reads tenant credentials from its standard
+// output. The command is split on whitespace and run without a shell.
+func runCredentialProcess(ctx context.Context, command string) (tenantCredentials, error) {
+	output, err := runCommand(ctx, command, nil, nil)
+	if err != nil {
+		return tenantCredentials{}, err
+	}
+	return parseCredentials(output)
+}
+
+// runCommand runs command, split on whitespace and followed by args, without a shell and
+// returns its standard output. env is added to the environment of the provider.
+func runCommand(ctx context.Context, command string, args []string, env []string) ([]byte, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("the command is empty")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, fields[0], append(fields[1:], args...)...) // #nosec G204 -- the command is set by the user in the provider configuration
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// parseCredentials parses tenant credentials given as a JSON object, or as INI style
+// "key = value" lines. INI sections are ignored and lines starting with # or ; are comments.
+func parseCredentials(data []byte) (tenantCredentials, error) {
+	var creds tenantCredentials
+
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		if err := json.Unmarshal(trimmed, &creds); err != nil {
+			return creds, fmt.Errorf("invalid JSON credentials: %w", err)
+		}
+		return creds, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") || strings.HasPrefix(text, "[") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return creds, fmt.Errorf("invalid INI credentials: line %d is not a key = value pair", line)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "accountid":
+			creds.AccountID = value
+		case "username":
+			creds.Username = value
+		case "password":
+			creds.Password = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return creds, fmt.Errorf("invalid INI credentials: %w", err)
+	}
+
+	return creds, nil
+}
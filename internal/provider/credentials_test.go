@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    tenantCredentials
+		wantErr bool
+	}{
+		{
+			name: "json",
+			data: `{"accountid": "12345678901234567890", "username": "admin", "password": "secret"}`,
+			want: tenantCredentials{AccountID: "12345678901234567890", Username: "admin", Password: "secret"},
+		},
+		{
+			name: "ini",
+			data: "# StorageGrid tenant\n[default]\naccountid = 12345678901234567890\nusername=admin\npassword = p=ss\n",
+			want: tenantCredentials{AccountID: "12345678901234567890", Username: "admin", Password: "p=ss"},
+		},
+		{
+			name:    "invalid json",
+			data:    `{"username": `,
+			wantErr: true,
+		},
+		{
+			name:    "invalid ini",
+			data:    "username admin",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCredentials([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCredentials() error = %v, want error %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Fatalf("parseCredentials() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCredentialProcess(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(name, []byte(`{"username": "admin", "password": "secret"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := runCredentialProcess(t.Context(), "cat "+name)
+	if err != nil {
+		t.Fatalf("runCredentialProcess() error = %v", err)
+	}
+	if want := (tenantCredentials{Username: "admin", Password: "secret"}); got != want {
+		t.Fatalf("runCredentialProcess() = %+v, want %+v", got, want)
+	}
+
+	if _, err := runCredentialProcess(t.Context(), "cat "+name+".missing"); err == nil {
+		t.Fatal("expected an error for a failing command")
+	}
+}
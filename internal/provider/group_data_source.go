@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
@@ -30,6 +33,7 @@ type GroupDataSource struct {
 
 type GroupDataSourceModel struct {
 	GroupName   types.String   `tfsdk:"group_name"`
+	ID          types.String   `tfsdk:"id"`
 	DisplayName types.String   `tfsdk:"display_name"`
 	UniqueName  types.String   `tfsdk:"unique_name"`
 	Policies    *PoliciesModel `tfsdk:"policies"`
@@ -49,9 +53,11 @@ type S3PolicyModel struct {
 
 // StatementModel maps the objects within the 'Statement' list.
 type StatementModel struct {
-	Effect   types.String   `tfsdk:"effect"`
-	Action   []types.String `tfsdk:"action"`
-	Resource []types.String `tfsdk:"resource"`
+	Sid       types.String                         `tfsdk:"sid"`
+	Effect    types.String                         `tfsdk:"effect"`
+	Action    []types.String                       `tfsdk:"action"`
+	Resource  []types.String                       `tfsdk:"resource"`
+	Condition map[string]map[string][]types.String `tfsdk:"condition"`
 }
 
 type ManagementPolicyModel struct {
@@ -72,8 +78,17 @@ func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Description: "Fetches information about a StorageGrid Group.",
 		Attributes: map[string]schema.Attribute{
 			"group_name": schema.StringAttribute{
-				Description: "The unique name of the group to fetch (e.g., 'group/example').",
-				Required:    true,
+				Description: "The name of the group to fetch, without the 'group/' prefix (e.g., 'example'). Exactly one of group_name and id must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the group to fetch, as generated by StorageGrid. Exactly one of group_name and id must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"display_name": schema.StringAttribute{
 				Description: "The display name of the group.",
@@ -100,6 +115,10 @@ func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 								Computed:    true,
 								NestedObject: schema.NestedAttributeObject{
 									Attributes: map[string]schema.Attribute{
+										"sid": schema.StringAttribute{
+											Description: "The identifier of the statement. Null when the statement has none.",
+											Computed:    true,
+										},
 										"effect": schema.StringAttribute{
 											Description: "The effect of the statement (e.g., 'Allow' or 'Deny').",
 											Computed:    true,
@@ -114,6 +133,12 @@ func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 											Computed:    true,
 											ElementType: types.StringType,
 										},
+										"condition": schema.MapAttribute{
+											Description: "The conditions of the statement, as a map of condition operators (e.g., 'StringLike') to maps of condition keys to their values. " +
+												"Null when the statement has no conditions.",
+											Computed:    true,
+											ElementType: types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+										},
 									},
 								},
 							},
@@ -159,15 +184,15 @@ func (d *GroupDataSource) Configure(_ context.Context, req datasource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -178,8 +203,12 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// The API looks groups up by ID or by unique name
 	groupName := "group/" + state.GroupName.ValueString()
-	apiResponse, err := d.client.GetGroup(groupName)
+	if !state.ID.IsNull() {
+		groupName = state.ID.ValueString()
+	}
+	apiResponse, err := d.client.GetGroup(ctx, groupName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read Group %s", groupName),
@@ -191,6 +220,9 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	group := apiResponse.Data
 
 	// Map API response data to the flattened Terraform state model
+	name, _ := groupNameFromUniqueName(group.UniqueName)
+	state.GroupName = types.StringValue(name)
+	state.ID = types.StringValue(group.ID)
 	state.DisplayName = types.StringValue(group.DisplayName)
 	state.UniqueName = types.StringValue(group.UniqueName)
 	state.Policies = &PoliciesModel{
@@ -210,18 +242,7 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Map S3 policy statements
 	var statements []StatementModel
 	for _, stmt := range group.Policies.S3.Statement {
-		statementState := StatementModel{
-			Effect:   types.StringValue(stmt.Effect),
-			Action:   make([]types.String, len(stmt.Action)),
-			Resource: make([]types.String, len(stmt.Resource)),
-		}
-		for i, action := range stmt.Action {
-			statementState.Action[i] = types.StringValue(action)
-		}
-		for i, resource := range stmt.Resource {
-			statementState.Resource[i] = types.StringValue(resource)
-		}
-		statements = append(statements, statementState)
+		statements = append(statements, statementToModel(stmt))
 	}
 	state.Policies.S3.Statement = statements
 
@@ -231,3 +252,26 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 }
+
+// statementToModel converts an S3 policy statement into the Terraform model.
+func statementToModel(stmt utils.Statement) StatementModel {
+	model := StatementModel{
+		Sid:      types.StringNull(),
+		Effect:   types.StringValue(stmt.Effect),
+		Action:   stringModels(stmt.Action),
+		Resource: stringModels(stmt.Resource),
+	}
+	if stmt.Sid != "" {
+		model.Sid = types.StringValue(stmt.Sid)
+	}
+	if len(stmt.Condition) > 0 {
+		model.Condition = make(map[string]map[string][]types.String, len(stmt.Condition))
+		for operator, keys := range stmt.Condition {
+			model.Condition[operator] = make(map[string][]types.String, len(keys))
+			for key, values := range keys {
+				model.Condition[operator][key] = stringModels(values)
+			}
+		}
+	}
+	return model
+}
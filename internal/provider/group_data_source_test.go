@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestStatementToModel(t *testing.T) {
+	stmt := utils.Statement{
+		Sid:      "ReadLogs",
+		Effect:   "Allow",
+		Action:   utils.StringOrSlice{"s3:GetObject"},
+		Resource: utils.StringOrSlice{"urn:sgws:s3:::logs/*"},
+		Condition: map[string]map[string]utils.StringOrSlice{
+			"StringLike": {"s3:prefix": {"app/*", "web/*"}},
+		},
+	}
+
+	want := StatementModel{
+		Sid:      types.StringValue("ReadLogs"),
+		Effect:   types.StringValue("Allow"),
+		Action:   []types.String{types.StringValue("s3:GetObject")},
+		Resource: []types.String{types.StringValue("urn:sgws:s3:::logs/*")},
+		Condition: map[string]map[string][]types.String{
+			"StringLike": {"s3:prefix": {types.StringValue("app/*"), types.StringValue("web/*")}},
+		},
+	}
+	if got := statementToModel(stmt); !reflect.DeepEqual(got, want) {
+		t.Fatalf("statementToModel() = %#v, want %#v", got, want)
+	}
+
+	got := statementToModel(utils.Statement{Effect: "Deny", Action: utils.StringOrSlice{"s3:*"}, Resource: utils.StringOrSlice{"*"}})
+	if !got.Sid.IsNull() || got.Condition != nil {
+		t.Fatalf("statement without Sid and Condition = %#v, want null sid and condition", got)
+	}
+}
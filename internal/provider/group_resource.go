@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"strings"
 
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
@@ -26,6 +30,7 @@ var (
 	_ resource.Resource                = &GroupResource{}
 	_ resource.ResourceWithConfigure   = &GroupResource{}
 	_ resource.ResourceWithImportState = &GroupResource{}
+	_ resource.ResourceWithModifyPlan  = &GroupResource{}
 )
 
 var managementAttributeTypes = map[string]attr.Type{
@@ -37,6 +42,122 @@ var managementAttributeTypes = map[string]attr.Type{
 	"view_all_containers":          types.BoolType,
 }
 
+// managementPermissionNames lists the management permissions that can be reconciled
+// selectively through manage_permissions.
+var managementPermissionNames = []string{
+	"manage_all_containers",
+	"manage_endpoints",
+	"manage_own_container_objects",
+	"manage_own_s3_credentials",
+	"root_access",
+	"view_all_containers",
+}
+
+// managementPermissionFields maps each management permission name to its field in the policy.
+func managementPermissionFields(p *utils.ManagementPolicy) map[string]*bool {
+	return map[string]*bool{
+		"manage_all_containers":        &p.ManageAllContainers,
+		"manage_endpoints":             &p.ManageEndpoints,
+		"manage_own_container_objects": &p.ManageOwnContainerObjects,
+		"manage_own_s3_credentials":    &p.ManageOwnS3Credentials,
+		"root_access":                  &p.RootAccess,
+		"view_all_containers":          &p.ViewAllContainers,
+	}
+}
+
+// overlayManagementPermissions returns base with the named permissions copied from overlay.
+// A nil names slice copies every permission, so the result equals overlay.
+func overlayManagementPermissions(base, overlay utils.ManagementPolicy, names []string) utils.ManagementPolicy {
+	if names == nil {
+		return overlay
+	}
+
+	result := base
+	resultFields := managementPermissionFields(&result)
+	overlayFields := managementPermissionFields(&overlay)
+	for _, name := range names {
+		if field, ok := resultFields[name]; ok {
+			*field = *overlayFields[name]
+		}
+	}
+
+	return result
+}
+
+// managementPolicyFromModel converts the Terraform management policy model into the API model.
+func managementPolicyFromModel(m ManagementPolicyModel) utils.ManagementPolicy {
+	return utils.ManagementPolicy{
+		ManageAllContainers:       m.ManageAllContainers.ValueBool(),
+		ManageEndpoints:           m.ManageEndpoints.ValueBool(),
+		ManageOwnContainerObjects: m.ManageOwnContainerObjects.ValueBool(),
+		ManageOwnS3Credentials:    m.ManageOwnS3Credentials.ValueBool(),
+		RootAccess:                m.RootAccess.ValueBool(),
+		ViewAllContainers:         m.ViewAllContainers.ValueBool(),
+	}
+}
+
+// managementPolicyToModel converts the API management policy into the Terraform model.
+func managementPolicyToModel(p utils.ManagementPolicy) ManagementPolicyModel {
+	return ManagementPolicyModel{
+		ManageAllContainers:       types.BoolValue(p.ManageAllContainers),
+		ManageEndpoints:           types.BoolValue(p.ManageEndpoints),
+		ManageOwnContainerObjects: types.BoolValue(p.ManageOwnContainerObjects),
+		ManageOwnS3Credentials:    types.BoolValue(p.ManageOwnS3Credentials),
+		RootAccess:                types.BoolValue(p.RootAccess),
+		ViewAllContainers:         types.BoolValue(p.ViewAllContainers),
+	}
+}
+
+// managedPermissionNames returns the permissions listed in manage_permissions, or nil
+// when the attribute is unset and every permission is reconciled.
+func managedPermissionNames(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	names := []string{}
+	diags := set.ElementsAs(ctx, &names, false)
+	return names, diags
+}
+
+const (
+	// localGroupPrefix and federatedGroupPrefix prefix the unique names of local groups
+	// and of groups synced from the identity source.
+	localGroupPrefix     = "group/"
+	federatedGroupPrefix = "federated-group/"
+)
+
+// groupUniqueName returns the unique name of a group, prefixed by its kind.
+func groupUniqueName(groupName string, federated bool) string {
+	if federated {
+		return federatedGroupPrefix + groupName
+	}
+	return localGroupPrefix + groupName
+}
+
+// groupNameFromUniqueName splits the unique name of a group into the group name and
+// whether the group is federated. Names without a prefix are local group names.
+func groupNameFromUniqueName(uniqueName string) (string, bool) {
+	if groupName, ok := strings.CutPrefix(uniqueName, federatedGroupPrefix); ok {
+		return groupName, true
+	}
+	return strings.TrimPrefix(uniqueName, localGroupPrefix), false
+}
+
+// groupPayload builds the request body that creates or updates a group. The display
+// name of federated groups comes from the identity source, so it is not sent for them.
+func groupPayload(groupName string, federated, managementReadOnly bool, policies utils.Policies) utils.GroupPayload {
+	payload := utils.GroupPayload{
+		UniqueName:         groupUniqueName(groupName, federated),
+		ManagementReadOnly: managementReadOnly,
+		Policies:           policies,
+	}
+	if !federated {
+		payload.DisplayName = groupName
+	}
+	return payload
+}
+
 // normalizeDisplayName returns a plan modifier that sets display_name to match group_name.
 func normalizeDisplayName() planmodifier.String {
 	return &normalizeDisplayNameModifier{}
@@ -65,8 +186,8 @@ func (m *normalizeDisplayNameModifier) PlanModifyString(ctx context.Context, req
 		return
 	}
 
-	// Set display_name to match group_name
-	if !plan.GroupName.IsNull() && !plan.GroupName.IsUnknown() {
+	// Set display_name to match group_name, federated groups keep the name of the identity source
+	if !plan.Federated.ValueBool() && !plan.GroupName.IsNull() && !plan.GroupName.IsUnknown() {
 		resp.PlanValue = types.StringValue(plan.GroupName.ValueString())
 		return
 	}
@@ -97,10 +218,12 @@ type GroupResourceModel struct {
 	GroupURN           types.String          `tfsdk:"group_urn"`
 	Federated          types.Bool            `tfsdk:"federated"`
 	ManagementReadOnly types.Bool            `tfsdk:"management_read_only"`
+	ManagePermissions  types.Set             `tfsdk:"manage_permissions"`
+	DeletionProtection types.Bool            `tfsdk:"deletion_protection"`
 }
 
 type PoliciesResourceModel struct {
-	S3         types.String          `tfsdk:"s3"`
+	S3         S3PolicyValue         `tfsdk:"s3"`
 	Management ManagementPolicyModel `tfsdk:"management"`
 }
 
@@ -113,8 +236,9 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		Description: "Manages a StorageGrid Group.",
 		Attributes: map[string]schema.Attribute{
 			"group_name": schema.StringAttribute{
-				Required:    true,
-				Description: "The unique name for the group (e.g., 'my-new-group'). The 'group/' prefix is added automatically. This cannot be changed after creation.",
+				Required: true,
+				Description: "The unique name for the group (e.g., 'my-new-group'). The 'group/' prefix, or 'federated-group/' for federated groups, is added automatically. " +
+					"For federated groups it is the name of the group in the identity source. This cannot be changed after creation.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -124,10 +248,12 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Contains the policy definitions for the group.",
 				Attributes: map[string]schema.Attribute{
 					"s3": schema.StringAttribute{
-						Required:    true,
-						Description: "The S3 policy for the group, provided as a JSON string. Use the `file()` function to load from a file.",
+						Required:   true,
+						CustomType: S3PolicyType{},
+						Description: "The S3 policy for the group, provided as a JSON string. Use the `file()` function to load from a file. " +
+							"Policies that only differ in formatting, statement order or single values written as lists are equal, and the state keeps the policy as written.",
 						PlanModifiers: []planmodifier.String{
-							suppressS3PolicyDiffs(),
+							summarizeS3PolicyChanges(),
 						},
 					},
 					"management": schema.SingleNestedAttribute{
@@ -203,7 +329,7 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"display_name": schema.StringAttribute{
-				Description: "The display name of the group.",
+				Description: "The display name of the group. It matches group_name, except for federated groups, where it is read from the identity source.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					normalizeDisplayName(),
@@ -224,10 +350,14 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"federated": schema.BoolAttribute{
-				Description: "Indicates if the group is federated.",
-				Computed:    true,
+				Description: "Whether the group is a federated group synced from the identity source of the tenant, rather than a local group. " +
+					"Terraform then manages the policies and management_read_only of the federated group, which must exist in the identity source. " +
+					"Changing it forces a new group. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
 				},
 			},
 			"management_read_only": schema.BoolAttribute{
@@ -236,6 +366,23 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether deleting the group fails, including replacements, as a guardrail on top of the prevent_destroy lifecycle setting. " +
+					"The setting is read from state, so it must be disabled and applied before the group can be destroyed. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"manage_permissions": schema.SetAttribute{
+				Description: "Limits which management permissions Terraform reconciles, e.g. `[\"root_access\", \"manage_endpoints\"]`. " +
+					"Permissions not listed keep their current value on the group and are not refreshed from it, so they can be managed outside Terraform. " +
+					"If omitted, all management permissions are reconciled.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(managementPermissionNames...)),
+				},
+			},
 		},
 	}
 }
@@ -243,18 +390,42 @@ func (r *GroupResource) Configure(_ context.Context, req resource.ConfigureReque
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
+}
+
+// ModifyPlan warns about actions of the S3 policy that StorageGrid does not implement
+// when the policy is new or changed.
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.SkipS3ActionValidation {
+		return
+	}
+
+	var policy, statePolicy S3PolicyValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policies").AtName("s3"), &policy)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policies").AtName("s3"), &statePolicy)...)
+	}
+	if resp.Diagnostics.HasError() || policy.IsNull() || policy.IsUnknown() || policy.Equal(statePolicy) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkS3PolicyActions(path.Root("policies").AtName("s3"), policy.ValueString())...)
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan GroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -270,27 +441,15 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	managementPayload := utils.ManagementPolicy{
-		ManageAllContainers:       plan.Policies.Management.ManageAllContainers.ValueBool(),
-		ManageEndpoints:           plan.Policies.Management.ManageEndpoints.ValueBool(),
-		ManageOwnContainerObjects: plan.Policies.Management.ManageOwnContainerObjects.ValueBool(),
-		ManageOwnS3Credentials:    plan.Policies.Management.ManageOwnS3Credentials.ValueBool(),
-		RootAccess:                plan.Policies.Management.RootAccess.ValueBool(),
-		ViewAllContainers:         plan.Policies.Management.ViewAllContainers.ValueBool(),
-	}
+	managementPayload := managementPolicyFromModel(plan.Policies.Management)
 	groupName := plan.GroupName.ValueString()
 
-	apiRequest := utils.GroupPayload{
-		UniqueName:         "group/" + groupName,
-		DisplayName:        groupName,
-		ManagementReadOnly: plan.ManagementReadOnly.ValueBool(),
-		Policies: utils.Policies{
-			S3:         s3Payload,
-			Management: managementPayload,
-		},
-	}
+	apiRequest := groupPayload(groupName, plan.Federated.ValueBool(), plan.ManagementReadOnly.ValueBool(), utils.Policies{
+		S3:         s3Payload,
+		Management: managementPayload,
+	})
 
-	createdGroup, err := r.client.CreateGroup(apiRequest)
+	createdGroup, err := r.client.CreateGroup(ctx, apiRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Error creating StorageGrid Group: %s", groupName), "Could not create group, unexpected error: "+err.Error())
 		return
@@ -322,9 +481,9 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	groupNameFromState := state.ID.ValueString()
 	id := state.ID.ValueString()
-	apiGroup, err := r.client.GetGroup(id)
+	apiGroup, err := r.client.GetGroup(ctx, id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if utils.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -334,8 +493,9 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	groupData := apiGroup.Data
 
+	groupName, _ := groupNameFromUniqueName(groupData.UniqueName)
 	state.ID = types.StringValue(groupData.ID)
-	state.GroupName = types.StringValue(strings.TrimPrefix(groupData.UniqueName, "group/"))
+	state.GroupName = types.StringValue(groupName)
 	state.DisplayName = types.StringValue(groupData.DisplayName)
 	state.UniqueName = types.StringValue(groupData.UniqueName)
 	state.AccountID = types.StringValue(groupData.AccountID)
@@ -343,14 +503,17 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.Federated = types.BoolValue(groupData.Federated)
 	state.ManagementReadOnly = types.BoolValue(groupData.ManagementReadOnly)
 
-	state.Policies.Management = ManagementPolicyModel{
-		ManageAllContainers:       types.BoolValue(groupData.Policies.Management.ManageAllContainers),
-		ManageEndpoints:           types.BoolValue(groupData.Policies.Management.ManageEndpoints),
-		ManageOwnContainerObjects: types.BoolValue(groupData.Policies.Management.ManageOwnContainerObjects),
-		ManageOwnS3Credentials:    types.BoolValue(groupData.Policies.Management.ManageOwnS3Credentials),
-		RootAccess:                types.BoolValue(groupData.Policies.Management.RootAccess),
-		ViewAllContainers:         types.BoolValue(groupData.Policies.Management.ViewAllContainers),
+	// Only refresh the permissions Terraform manages; the others keep their state value
+	managedNames, diags := managedPermissionNames(ctx, state.ManagePermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	state.Policies.Management = managementPolicyToModel(overlayManagementPermissions(
+		managementPolicyFromModel(state.Policies.Management),
+		groupData.Policies.Management,
+		managedNames,
+	))
 
 	s3PolicyFromAPIBytes, err := json.Marshal(groupData.Policies.S3)
 	if err != nil {
@@ -358,15 +521,8 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	equal, err := awspolicy.PoliciesAreEquivalent(string(s3PolicyFromAPIBytes), state.Policies.S3.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Comparison Error", "Failed to compare S3 policies: "+err.Error())
-		return
-	}
-
-	if !equal {
-		state.Policies.S3 = types.StringValue(string(s3PolicyFromAPIBytes))
-	}
+	// The framework keeps the policy in state when it is equivalent to the refreshed one
+	state.Policies.S3 = NewS3PolicyValue(string(s3PolicyFromAPIBytes))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -375,6 +531,11 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 }
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan GroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -393,51 +554,49 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	managementPayload := utils.ManagementPolicy{
-		ManageAllContainers:       plan.Policies.Management.ManageAllContainers.ValueBool(),
-		ManageEndpoints:           plan.Policies.Management.ManageEndpoints.ValueBool(),
-		ManageOwnContainerObjects: plan.Policies.Management.ManageOwnContainerObjects.ValueBool(),
-		ManageOwnS3Credentials:    plan.Policies.Management.ManageOwnS3Credentials.ValueBool(),
-		RootAccess:                plan.Policies.Management.RootAccess.ValueBool(),
-		ViewAllContainers:         plan.Policies.Management.ViewAllContainers.ValueBool(),
-	}
+	managementPayload := managementPolicyFromModel(plan.Policies.Management)
 
 	groupName := state.GroupName.ValueString()
-	apiRequest := utils.GroupPayload{
-		UniqueName:         "group/" + groupName,
-		DisplayName:        groupName,
-		ManagementReadOnly: plan.ManagementReadOnly.ValueBool(),
-		Policies: utils.Policies{
-			S3:         s3Payload,
-			Management: managementPayload,
-		},
-	}
 	id := state.ID.ValueString()
-	_, err := r.client.UpdateGroup(id, apiRequest)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating StorageGrid Group",
-			fmt.Sprintf("Could not update group policies for ID %s: %s", groupName, err.Error()),
-		)
+
+	// When only some permissions are managed, keep the current value of the others
+	managedNames, diags := managedPermissionNames(ctx, plan.ManagePermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	if managedNames != nil {
+		currentGroup, err := r.client.GetGroup(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading StorageGrid Group",
+				fmt.Sprintf("Could not read current group data for %s before update: %s", groupName, err.Error()),
+			)
+			return
+		}
+		managementPayload = overlayManagementPermissions(currentGroup.Data.Policies.Management, managementPayload, managedNames)
+	}
 
-	updatedGroup, err := r.client.GetGroup(id)
+	apiRequest := groupPayload(groupName, state.Federated.ValueBool(), plan.ManagementReadOnly.ValueBool(), utils.Policies{
+		S3:         s3Payload,
+		Management: managementPayload,
+	})
+	// The PUT response contains the full updated group, so no follow-up read is needed
+	updatedGroup, err := r.client.UpdateGroup(ctx, id, apiRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Reading StorageGrid Group",
-			fmt.Sprintf("Could not read updated group data for %s after update: %s", groupName, err.Error()),
+			"Error Updating StorageGrid Group",
+			fmt.Sprintf("Could not update group policies for ID %s: %s", groupName, err.Error()),
 		)
 		return
 	}
 	groupData := updatedGroup.Data
 
-	plan.Policies.Management.ManageAllContainers = types.BoolValue(groupData.Policies.Management.ManageAllContainers)
-	plan.Policies.Management.ManageEndpoints = types.BoolValue(groupData.Policies.Management.ManageEndpoints)
-	plan.Policies.Management.ManageOwnContainerObjects = types.BoolValue(groupData.Policies.Management.ManageOwnContainerObjects)
-	plan.Policies.Management.ManageOwnS3Credentials = types.BoolValue(groupData.Policies.Management.ManageOwnS3Credentials)
-	plan.Policies.Management.RootAccess = types.BoolValue(groupData.Policies.Management.RootAccess)
-	plan.Policies.Management.ViewAllContainers = types.BoolValue(groupData.Policies.Management.ViewAllContainers)
+	plan.Policies.Management = managementPolicyToModel(overlayManagementPermissions(
+		managementPolicyFromModel(plan.Policies.Management),
+		groupData.Policies.Management,
+		managedNames,
+	))
 
 	plan.ID = types.StringValue(groupData.ID)
 	plan.DisplayName = types.StringValue(groupData.DisplayName)
@@ -454,6 +613,11 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 }
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state GroupResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -461,8 +625,13 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "group", state.GroupName.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
-	err := r.client.DeleteGroup(id)
+	err := r.client.DeleteGroup(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting StorageGrid Group",
@@ -473,14 +642,12 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	groupName := req.ID
+	// Federated groups are imported by their unique name, local groups also by their name
+	groupName, federated := groupNameFromUniqueName(req.ID)
 
-	// The API expects the unique name to be prefixed with "group/".
-	apiUniqueName := "group/" + groupName
-
-	apiGroup, err := r.client.GetGroup(apiUniqueName)
+	apiGroup, err := r.client.GetGroup(ctx, groupUniqueName(groupName, federated))
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if utils.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Group Not Found",
 				fmt.Sprintf("Cannot import a group with unique name '%s' because it does not exist.", groupName),
@@ -499,9 +666,12 @@ func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	state.ID = types.StringValue(groupData.ID)
 
-	groupName = strings.TrimPrefix(groupData.UniqueName, "group/")
+	groupName, federated = groupNameFromUniqueName(groupData.UniqueName)
 	state.GroupName = types.StringValue(groupName)
 	state.DisplayName = types.StringValue(groupName)
+	if federated {
+		state.DisplayName = types.StringValue(groupData.DisplayName)
+	}
 	state.UniqueName = types.StringValue(groupData.UniqueName)
 	state.AccountID = types.StringValue(groupData.AccountID)
 	state.GroupURN = types.StringValue(groupData.GroupURN)
@@ -509,14 +679,9 @@ func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStat
 	state.ManagementReadOnly = types.BoolValue(groupData.ManagementReadOnly)
 
 	// Populate nested management policy object.
-	state.Policies.Management = ManagementPolicyModel{
-		ManageAllContainers:       types.BoolValue(groupData.Policies.Management.ManageAllContainers),
-		ManageEndpoints:           types.BoolValue(groupData.Policies.Management.ManageEndpoints),
-		ManageOwnContainerObjects: types.BoolValue(groupData.Policies.Management.ManageOwnContainerObjects),
-		ManageOwnS3Credentials:    types.BoolValue(groupData.Policies.Management.ManageOwnS3Credentials),
-		RootAccess:                types.BoolValue(groupData.Policies.Management.RootAccess),
-		ViewAllContainers:         types.BoolValue(groupData.Policies.Management.ViewAllContainers),
-	}
+	state.Policies.Management = managementPolicyToModel(groupData.Policies.Management)
+	state.ManagePermissions = types.SetNull(types.StringType)
+	state.DeletionProtection = types.BoolValue(false)
 
 	// Marshal the S3 policy from the API into a string.
 	s3PolicyFromAPIBytes, err := json.Marshal(groupData.Policies.S3)
@@ -524,7 +689,7 @@ func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStat
 		resp.Diagnostics.AddError("Error Processing S3 Policy on Import", "Could not marshal S3 policy from API into string: "+err.Error())
 		return
 	}
-	state.Policies.S3 = types.StringValue(string(s3PolicyFromAPIBytes))
+	state.Policies.S3 = NewS3PolicyValue(string(s3PolicyFromAPIBytes))
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
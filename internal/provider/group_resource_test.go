@@ -7,8 +7,83 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
 
+func TestOverlayManagementPermissions(t *testing.T) {
+	remote := utils.ManagementPolicy{RootAccess: true, ViewAllContainers: true}
+	desired := utils.ManagementPolicy{ManageEndpoints: true}
+
+	tests := []struct {
+		name  string
+		names []string
+		want  utils.ManagementPolicy
+	}{
+		{
+			name:  "all permissions managed",
+			names: nil,
+			want:  desired,
+		},
+		{
+			name:  "no permissions managed",
+			names: []string{},
+			want:  remote,
+		},
+		{
+			name:  "subset managed",
+			names: []string{"root_access", "manage_endpoints"},
+			want:  utils.ManagementPolicy{ManageEndpoints: true, ViewAllContainers: true},
+		},
+		{
+			name:  "unknown names are ignored",
+			names: []string{"not_a_permission"},
+			want:  remote,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overlayManagementPermissions(remote, desired, tt.names); got != tt.want {
+				t.Fatalf("overlayManagementPermissions() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupNameFromUniqueName(t *testing.T) {
+	tests := []struct {
+		uniqueName    string
+		wantName      string
+		wantFederated bool
+	}{
+		{uniqueName: "group/developers", wantName: "developers"},
+		{uniqueName: "federated-group/developers", wantName: "developers", wantFederated: true},
+		{uniqueName: "developers", wantName: "developers"},
+	}
+
+	for _, tt := range tests {
+		name, federated := groupNameFromUniqueName(tt.uniqueName)
+		if name != tt.wantName || federated != tt.wantFederated {
+			t.Fatalf("groupNameFromUniqueName(%q) = %q, %t, want %q, %t", tt.uniqueName, name, federated, tt.wantName, tt.wantFederated)
+		}
+		if tt.uniqueName != tt.wantName && groupUniqueName(name, federated) != tt.uniqueName {
+			t.Fatalf("groupUniqueName(%q, %t) = %q, want %q", name, federated, groupUniqueName(name, federated), tt.uniqueName)
+		}
+	}
+}
+
+func TestGroupPayload(t *testing.T) {
+	local := groupPayload("developers", false, true, utils.Policies{})
+	if local.UniqueName != "group/developers" || local.DisplayName != "developers" || !local.ManagementReadOnly {
+		t.Fatalf("local group payload = %#v", local)
+	}
+
+	federated := groupPayload("developers", true, false, utils.Policies{})
+	if federated.UniqueName != "federated-group/developers" || federated.DisplayName != "" {
+		t.Fatalf("federated group payload = %#v, want no display name", federated)
+	}
+}
+
 func TestAccGroupResource_WithCondition(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
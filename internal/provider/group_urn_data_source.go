@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &GroupURNDataSource{}
+	_ datasource.DataSourceWithConfigure = &GroupURNDataSource{}
+)
+
+func NewGroupURNDataSource() datasource.DataSource {
+	return &GroupURNDataSource{}
+}
+
+// GroupURNDataSource defines the data source implementation.
+type GroupURNDataSource struct {
+	client *utils.Client
+}
+
+// GroupURNDataSourceModel describes the data source data model.
+type GroupURNDataSourceModel struct {
+	GroupName  types.String `tfsdk:"group_name"`
+	ID         types.String `tfsdk:"id"`
+	UniqueName types.String `tfsdk:"unique_name"`
+	GroupURN   types.String `tfsdk:"group_urn"`
+}
+
+func (d *GroupURNDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_urn"
+}
+
+func (d *GroupURNDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves the ID and URN of a StorageGrid Group by name, without the policy payload. Intended for composing bucket and group policies.",
+		Attributes: map[string]schema.Attribute{
+			"group_name": schema.StringAttribute{
+				Description: "The short name of the group to look up (without the 'group/' prefix).",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier (ID) of the group.",
+				Computed:    true,
+			},
+			"unique_name": schema.StringAttribute{
+				Description: "The unique name of the group.",
+				Computed:    true,
+			},
+			"group_urn": schema.StringAttribute{
+				Description: "The URN of the group, for use as a principal in policies.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *GroupURNDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = providerData.Client
+}
+
+func (d *GroupURNDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state GroupURNDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := "group/" + state.GroupName.ValueString()
+	apiResponse, err := d.client.GetGroup(ctx, groupName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read Group %s", groupName),
+			err.Error(),
+		)
+		return
+	}
+
+	group := apiResponse.Data
+
+	state.ID = types.StringValue(group.ID)
+	state.UniqueName = types.StringValue(group.UniqueName)
+	state.GroupURN = types.StringValue(group.GroupURN)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
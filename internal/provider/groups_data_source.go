@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &GroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &GroupsDataSource{}
+)
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	client *utils.Client
+}
+
+// GroupsDataSourceModel describes the data source data model.
+type GroupsDataSourceModel struct {
+	NamePrefix types.String        `tfsdk:"name_prefix"`
+	Federated  types.Bool          `tfsdk:"federated"`
+	Groups     []GroupSummaryModel `tfsdk:"groups"`
+}
+
+// GroupSummaryModel describes a single group of the list.
+type GroupSummaryModel struct {
+	ID                 types.String          `tfsdk:"id"`
+	GroupName          types.String          `tfsdk:"group_name"`
+	UniqueName         types.String          `tfsdk:"unique_name"`
+	DisplayName        types.String          `tfsdk:"display_name"`
+	Federated          types.Bool            `tfsdk:"federated"`
+	ManagementReadOnly types.Bool            `tfsdk:"management_read_only"`
+	Management         ManagementPolicyModel `tfsdk:"management"`
+	S3Policy           types.String          `tfsdk:"s3_policy"`
+	S3StatementCount   types.Int64           `tfsdk:"s3_statement_count"`
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the groups of the StorageGrid tenant account with their management permissions and a summary of their S3 policies.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list groups whose name, without the 'group/' or 'federated-group/' prefix, starts with this prefix.",
+				Optional:    true,
+			},
+			"federated": schema.BoolAttribute{
+				Description: "Only list federated groups when true, or only local groups when false. Lists both when unset.",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "The groups of the tenant account.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the group, generated by StorageGrid.",
+							Computed:    true,
+						},
+						"group_name": schema.StringAttribute{
+							Description: "The name of the group, without the 'group/' or 'federated-group/' prefix.",
+							Computed:    true,
+						},
+						"unique_name": schema.StringAttribute{
+							Description: "The unique name of the group.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the group.",
+							Computed:    true,
+						},
+						"federated": schema.BoolAttribute{
+							Description: "Whether the group is synced from the identity source.",
+							Computed:    true,
+						},
+						"management_read_only": schema.BoolAttribute{
+							Description: "Whether the group has read-only management access.",
+							Computed:    true,
+						},
+						"management": schema.SingleNestedAttribute{
+							Description: "Management policy permissions of the group.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"manage_all_containers": schema.BoolAttribute{
+									Description: "Permission to manage all containers.",
+									Computed:    true,
+								},
+								"manage_endpoints": schema.BoolAttribute{
+									Description: "Permission to manage endpoints.",
+									Computed:    true,
+								},
+								"manage_own_container_objects": schema.BoolAttribute{
+									Description: "Permission to manage objects in own containers.",
+									Computed:    true,
+								},
+								"manage_own_s3_credentials": schema.BoolAttribute{
+									Description: "Permission to manage own S3 credentials.",
+									Computed:    true,
+								},
+								"root_access": schema.BoolAttribute{
+									Description: "Root access permissions.",
+									Computed:    true,
+								},
+								"view_all_containers": schema.BoolAttribute{
+									Description: "Permission to view all containers.",
+									Computed:    true,
+								},
+							},
+						},
+						"s3_policy": schema.StringAttribute{
+							Description: "The S3 policy of the group as a JSON string. Use `jsondecode()` to inspect its statements.",
+							Computed:    true,
+						},
+						"s3_statement_count": schema.Int64Attribute{
+							Description: "The number of statements of the S3 policy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state GroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListGroups(ctx, state.Federated.ValueBoolPointer())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Groups",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map API response data to the Terraform state model
+	state.Groups = []GroupSummaryModel{}
+	for _, group := range groups {
+		groupName, _ := groupNameFromUniqueName(group.UniqueName)
+		if !strings.HasPrefix(groupName, state.NamePrefix.ValueString()) {
+			continue
+		}
+
+		summary, err := groupSummary(group)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Groups",
+				fmt.Sprintf("Could not marshal the S3 policy of group %s: %s", group.UniqueName, err),
+			)
+			return
+		}
+		state.Groups = append(state.Groups, summary)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// groupSummary converts a group of the list into the Terraform model.
+func groupSummary(group utils.GroupData) (GroupSummaryModel, error) {
+	s3Policy, err := json.Marshal(group.Policies.S3)
+	if err != nil {
+		return GroupSummaryModel{}, err
+	}
+
+	groupName, _ := groupNameFromUniqueName(group.UniqueName)
+	return GroupSummaryModel{
+		ID:                 types.StringValue(group.ID),
+		GroupName:          types.StringValue(groupName),
+		UniqueName:         types.StringValue(group.UniqueName),
+		DisplayName:        types.StringValue(group.DisplayName),
+		Federated:          types.BoolValue(group.Federated),
+		ManagementReadOnly: types.BoolValue(group.ManagementReadOnly),
+		Management:         managementPolicyToModel(group.Policies.Management),
+		S3Policy:           types.StringValue(string(s3Policy)),
+		S3StatementCount:   types.Int64Value(int64(len(group.Policies.S3.Statement))),
+	}, nil
+}
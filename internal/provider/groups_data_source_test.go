@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestGroupSummary(t *testing.T) {
+	group := utils.GroupData{
+		ID:          "a1b2",
+		UniqueName:  "federated-group/auditors",
+		DisplayName: "Auditors",
+		Federated:   true,
+		Policies: utils.Policies{
+			Management: utils.ManagementPolicy{ViewAllContainers: true},
+			S3: utils.S3Policy{Statement: []utils.Statement{
+				{Effect: "Allow", Action: utils.StringOrSlice{"s3:GetObject"}, Resource: utils.StringOrSlice{"urn:sgws:s3:::*"}},
+			}},
+		},
+	}
+
+	summary, err := groupSummary(group)
+	if err != nil {
+		t.Fatalf("groupSummary returned error: %v", err)
+	}
+	if summary.GroupName.ValueString() != "auditors" || !summary.Federated.ValueBool() {
+		t.Fatalf("group_name = %s, federated = %s, want auditors and true", summary.GroupName, summary.Federated)
+	}
+	if !summary.Management.ViewAllContainers.ValueBool() || summary.Management.RootAccess.ValueBool() {
+		t.Fatalf("management = %#v", summary.Management)
+	}
+	if summary.S3StatementCount.ValueInt64() != 1 {
+		t.Fatalf("s3_statement_count = %s, want 1", summary.S3StatementCount)
+	}
+	if want := `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["urn:sgws:s3:::*"]}]}`; summary.S3Policy.ValueString() != want {
+		t.Fatalf("s3_policy = %s, want %s", summary.S3Policy.ValueString(), want)
+	}
+}
@@ -5,42 +5,169 @@ package provider
 
 import (
 	"context"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
 
-func suppressS3PolicyDiffs() planmodifier.String {
-	return &s3PolicyDiffSuppressor{}
+func summarizeS3PolicyChanges() planmodifier.String {
+	return &s3PolicyChangeSummarizer{}
 }
 
-type s3PolicyDiffSuppressor struct{}
+type s3PolicyChangeSummarizer struct{}
 
-func (s *s3PolicyDiffSuppressor) Description(ctx context.Context) string {
-	return "Suppresses differences in S3 Policy JSON strings that are semantically equal."
+func (s *s3PolicyChangeSummarizer) Description(ctx context.Context) string {
+	return "Adds a warning to the plan summarizing the S3 Policy statements that are added, removed or modified."
 }
 
-func (s *s3PolicyDiffSuppressor) MarkdownDescription(ctx context.Context) string {
+func (s *s3PolicyChangeSummarizer) MarkdownDescription(ctx context.Context) string {
 	return s.Description(ctx)
 }
 
-func (s *s3PolicyDiffSuppressor) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+func (s *s3PolicyChangeSummarizer) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
 		return
 	}
-	if req.StateValue.IsNull() {
+	if req.StateValue.IsNull() || req.PlanValue.Equal(req.StateValue) {
 		return
 	}
 
-	planJSON := req.PlanValue.ValueString()
-	stateJSON := req.StateValue.ValueString()
+	var statePolicy, planPolicy utils.S3Policy
+	if err := json.Unmarshal([]byte(req.StateValue.ValueString()), &statePolicy); err != nil {
+		return
+	}
+	if err := json.Unmarshal([]byte(req.PlanValue.ValueString()), &planPolicy); err != nil {
+		return
+	}
 
-	equal, err := awspolicy.PoliciesAreEquivalent(planJSON, stateJSON)
-	if err != nil {
-		resp.Diagnostics.AddError("S3 Policy Comparison Error", "Failed to compare JSON strings: "+err.Error())
+	changes := diffS3Policies(statePolicy, planPolicy)
+	if len(changes) == 0 {
 		return
 	}
 
-	if equal {
-		resp.PlanValue = req.StateValue
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"S3 Policy Changes",
+		"The following S3 Policy changes will be applied:\n\n"+strings.Join(changes, "\n"),
+	)
+}
+
+// diffS3Policies describes the differences between two S3 policies, one line per change.
+// Statements are matched by Sid; statements without a Sid are matched by their position.
+func diffS3Policies(old, new utils.S3Policy) []string {
+	var changes []string
+
+	if old.Version != new.Version {
+		changes = append(changes, fmt.Sprintf("  ~ Version: %q -> %q", old.Version, new.Version))
+	}
+
+	oldStatements := statementsByKey(old.Statement)
+	newStatements := statementsByKey(new.Statement)
+
+	for _, key := range statementKeys(old.Statement) {
+		newStmt, ok := newStatements[key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("  - statement %s removed", key))
+			continue
+		}
+		if fields := changedStatementFields(oldStatements[key], newStmt); len(fields) > 0 {
+			changes = append(changes, fmt.Sprintf("  ~ statement %s modified (%s)", key, strings.Join(fields, ", ")))
+		}
+	}
+
+	for _, key := range statementKeys(new.Statement) {
+		if _, ok := oldStatements[key]; !ok {
+			changes = append(changes, fmt.Sprintf("  + statement %s added", key))
+		}
+	}
+
+	return changes
+}
+
+func statementKey(index int, stmt utils.Statement) string {
+	if stmt.Sid != "" {
+		return fmt.Sprintf("%q", stmt.Sid)
+	}
+	return fmt.Sprintf("#%d (no Sid)", index)
+}
+
+func statementKeys(statements []utils.Statement) []string {
+	keys := make([]string, 0, len(statements))
+	for i, stmt := range statements {
+		keys = append(keys, statementKey(i, stmt))
+	}
+	return keys
+}
+
+func statementsByKey(statements []utils.Statement) map[string]utils.Statement {
+	byKey := make(map[string]utils.Statement, len(statements))
+	for i, stmt := range statements {
+		byKey[statementKey(i, stmt)] = stmt
+	}
+	return byKey
+}
+
+// changedStatementFields returns the names of the statement fields that differ, ignoring
+// the order of principals, actions, resources and condition values.
+func changedStatementFields(old, new utils.Statement) []string {
+	var fields []string
+	if !strings.EqualFold(old.Effect, new.Effect) {
+		fields = append(fields, "Effect")
+	}
+	if !reflect.DeepEqual(normalizePrincipal(old.Principal), normalizePrincipal(new.Principal)) {
+		fields = append(fields, "Principal")
+	}
+	if !reflect.DeepEqual(normalizePrincipal(old.NotPrincipal), normalizePrincipal(new.NotPrincipal)) {
+		fields = append(fields, "NotPrincipal")
+	}
+	if !sameStrings(old.Action, new.Action) {
+		fields = append(fields, "Action")
+	}
+	if !sameStrings(old.NotAction, new.NotAction) {
+		fields = append(fields, "NotAction")
+	}
+	if !sameStrings(old.Resource, new.Resource) {
+		fields = append(fields, "Resource")
+	}
+	if !sameStrings(old.NotResource, new.NotResource) {
+		fields = append(fields, "NotResource")
+	}
+	if !reflect.DeepEqual(normalizeCondition(old.Condition), normalizeCondition(new.Condition)) {
+		fields = append(fields, "Condition")
+	}
+	return fields
+}
+
+func sameStrings(a, b []string) bool {
+	return slices.Equal(sortedStrings(a), sortedStrings(b))
+}
+
+func sortedStrings(values []string) []string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+func normalizeCondition(condition map[string]map[string]utils.StringOrSlice) map[string]map[string][]string {
+	normalized := make(map[string]map[string][]string, len(condition))
+	for operator, keys := range condition {
+		normalized[operator] = make(map[string][]string, len(keys))
+		for key, values := range keys {
+			normalized[operator][key] = sortedStrings(values)
+		}
+	}
+	return normalized
+}
+
+func normalizePrincipal(principal utils.Principal) map[string][]string {
+	normalized := make(map[string][]string, len(principal))
+	for principalType, values := range principal {
+		normalized[principalType] = sortedStrings(values)
 	}
+	return normalized
 }
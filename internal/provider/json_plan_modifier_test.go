@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestDiffS3Policies(t *testing.T) {
+	parse := func(t *testing.T, policy string) utils.S3Policy {
+		t.Helper()
+		var p utils.S3Policy
+		if err := json.Unmarshal([]byte(policy), &p); err != nil {
+			t.Fatalf("failed to parse policy: %v", err)
+		}
+		return p
+	}
+
+	old := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "arn:aws:s3:::bucket/*"},
+			{"Sid": "Write", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::bucket/*"},
+			{"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "arn:aws:s3:::bucket"}
+		]
+	}`
+
+	tests := []struct {
+		name   string
+		policy string
+		want   []string
+	}{
+		{
+			name: "reordered values are not a change",
+			policy: `{
+				"Version": "2012-10-17",
+				"Statement": [
+					{"Sid": "Read", "Effect": "Allow", "Action": ["s3:ListBucket", "s3:GetObject"], "Resource": ["arn:aws:s3:::bucket/*"]},
+					{"Sid": "Write", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::bucket/*"},
+					{"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "arn:aws:s3:::bucket"}
+				]
+			}`,
+			want: nil,
+		},
+		{
+			name: "added removed and modified statements",
+			policy: `{
+				"Version": "2012-10-17",
+				"Statement": [
+					{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*",
+					 "Condition": {"StringLike": {"s3:prefix": "data/*"}}},
+					{"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "arn:aws:s3:::bucket"},
+					{"Sid": "Tagging", "Effect": "Allow", "Action": "s3:PutObjectTagging", "Resource": "arn:aws:s3:::bucket/*"}
+				]
+			}`,
+			want: []string{
+				`  ~ statement "Read" modified (Action, Condition)`,
+				`  - statement "Write" removed`,
+				`  - statement #2 (no Sid) removed`,
+				`  + statement #1 (no Sid) added`,
+				`  + statement "Tagging" added`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffS3Policies(parse(t, old), parse(t, tt.policy))
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("diffS3Policies() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffS3PoliciesPrincipals(t *testing.T) {
+	var old, updated utils.S3Policy
+	if err := json.Unmarshal([]byte(`{"Statement": [
+		{"Sid": "Public", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"},
+		{"Sid": "Tenants", "Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam::111:root", "arn:aws:iam::222:root"]}, "Action": "s3:ListBucket", "Resource": "arn:aws:s3:::bucket"},
+		{"Sid": "DenyOthers", "Effect": "Deny", "NotPrincipal": {"AWS": "arn:aws:iam::111:root"}, "NotAction": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"}
+	]}`), &old); err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"Statement": [
+		{"Sid": "Public", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::111:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"},
+		{"Sid": "Tenants", "Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam::222:root", "arn:aws:iam::111:root"]}, "Action": "s3:ListBucket", "Resource": "arn:aws:s3:::bucket"},
+		{"Sid": "DenyOthers", "Effect": "Deny", "NotPrincipal": {"AWS": "arn:aws:iam::222:root"}, "NotAction": "s3:PutObject", "NotResource": "arn:aws:s3:::bucket/public/*"}
+	]}`), &updated); err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+
+	want := []string{
+		`  ~ statement "Public" modified (Principal)`,
+		`  ~ statement "DenyOthers" modified (NotPrincipal, NotAction, Resource, NotResource)`,
+	}
+	if got := diffS3Policies(old, updated); !slices.Equal(got, want) {
+		t.Fatalf("diffS3Policies() = %#v, want %#v", got, want)
+	}
+}
@@ -5,15 +5,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -32,9 +44,67 @@ type StorageGridProvider struct {
 // StorageGridProviderModel describes the provider data model.
 type StorageGridProviderModel struct {
 	Endpoints *EndpointsModel `tfsdk:"endpoints"`
+	Grid      *GridModel      `tfsdk:"grid"`
+	S3Port    types.Int64     `tfsdk:"s3_port"`
 	AccountID types.String    `tfsdk:"accountid"`
 	Username  types.String    `tfsdk:"username"`
 	Password  types.String    `tfsdk:"password"`
+	Token     types.String    `tfsdk:"token"`
+
+	CredentialsFile   types.String `tfsdk:"credentials_file"`
+	CredentialProcess types.String `tfsdk:"credential_process"`
+
+	CacheToken types.Bool `tfsdk:"cache_token"`
+
+	AuthMode            types.String `tfsdk:"auth_mode"`
+	SAMLResponseProcess types.String `tfsdk:"saml_response_process"`
+
+	S3AccessKey types.String `tfsdk:"s3_access_key"`
+	S3SecretKey types.String `tfsdk:"s3_secret_key"`
+
+	MaxAccessKeyLifetimeDays  types.Int64  `tfsdk:"max_access_key_lifetime_days"`
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+	DefaultRegion             types.String `tfsdk:"default_region"`
+	SkipS3ActionValidation    types.Bool   `tfsdk:"skip_s3_action_validation"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	S3CACertPEM          types.String `tfsdk:"s3_ca_cert_pem"`
+	S3CACertFile         types.String `tfsdk:"s3_ca_cert_file"`
+	S3InsecureSkipVerify types.Bool   `tfsdk:"s3_insecure_skip_verify"`
+	S3TLSServerName      types.String `tfsdk:"s3_tls_server_name"`
+	S3HostHeader         types.String `tfsdk:"s3_host_header"`
+
+	Timeouts           *ProviderTimeoutsModel   `tfsdk:"timeouts"`
+	TemporaryAccessKey *TemporaryAccessKeyModel `tfsdk:"temporary_access_key"`
+	RetryMaxAttempts   types.Int64              `tfsdk:"retry_max_attempts"`
+	RetryMinDelay      types.String             `tfsdk:"retry_min_delay"`
+	RetryMaxDelay      types.String             `tfsdk:"retry_max_delay"`
+	RetryStatusCodes   types.List               `tfsdk:"retry_status_codes"`
+	BucketCacheTTL     types.String             `tfsdk:"bucket_cache_ttl"`
+	BucketListInclude  types.List               `tfsdk:"bucket_list_include"`
+	AuditLogPath       types.String             `tfsdk:"audit_log_path"`
+	ExtraHeaders       types.Map                `tfsdk:"extra_headers"`
+	LogCurlCommands    types.Bool               `tfsdk:"log_curl_commands"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	MaxIdleConns    types.Int64  `tfsdk:"max_idle_conns"`
+	MaxConnsPerHost types.Int64  `tfsdk:"max_conns_per_host"`
+	IdleConnTimeout types.String `tfsdk:"idle_conn_timeout"`
+}
+
+// ProviderTimeoutsModel describes the timeouts configuration block.
+type ProviderTimeoutsModel struct {
+	Read        types.String `tfsdk:"read"`
+	Write       types.String `tfsdk:"write"`
+	Delete      types.String `tfsdk:"delete"`
+	S3Operation types.String `tfsdk:"s3_operation"`
 }
 
 // EndpointsModel describes the endpoints configuration block.
@@ -43,6 +113,29 @@ type EndpointsModel struct {
 	S3   types.String `tfsdk:"s3"`
 }
 
+// TemporaryAccessKeyModel describes the temporary_access_key configuration block.
+type TemporaryAccessKeyModel struct {
+	Lifetime          types.String `tfsdk:"lifetime"`
+	DisplayNamePrefix types.String `tfsdk:"display_name_prefix"`
+	Reuse             types.Bool   `tfsdk:"reuse"`
+}
+
+// GridModel describes the grid administrator configuration block.
+type GridModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// ProviderData is passed from the provider to the Configure methods of resources and data sources.
+type ProviderData struct {
+	// Client is signed in to the tenant account.
+	Client *utils.Client
+	// Grid is signed in as a grid administrator, for data sources of grid-level objects
+	// such as storagegrid_tenant_accounts. It is nil when no grid credentials are configured.
+	Grid *utils.Client
+}
+
 func (p *StorageGridProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "storagegrid"
 	resp.Version = p.version
@@ -51,7 +144,7 @@ func (p *StorageGridProvider) Metadata(ctx context.Context, req provider.Metadat
 // Schema defines the provider-level schema for configuration data.
 func (p *StorageGridProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The StorageGrid provider enables Terraform management of StorageGrid IAM and S3 resources including users, groups, access keys, and S3 buckets. This provider requires StorageGrid with v4 API support and is not compatible with older StorageGrid versions that only support v3 or earlier APIs.",
+		Description: "The StorageGrid provider enables Terraform management of StorageGrid IAM and S3 resources including users, groups, access keys, and S3 buckets. The provider detects the management API versions offered by the grid and uses v4. Grids that only offer v3 are used with v3 on a best-effort basis; older API versions are not supported.",
 		Attributes: map[string]schema.Attribute{
 			"accountid": schema.StringAttribute{
 				Description: "Account ID for target StorageGrid tenant. May also be provided via STORAGEGRID_ACCOUNTID environment variable.",
@@ -66,27 +159,331 @@ func (p *StorageGridProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"token": schema.StringAttribute{
+				Description: "Pre-issued bearer token for the StorageGrid tenant, used instead of signing in with accountid, username and password. " +
+					"The token is not renewed when it expires. May also be provided via STORAGEGRID_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"cache_token": schema.BoolAttribute{
+				Description: "When true, the bearer token of a password sign-in is kept in the user cache directory, in a file that only the current user can read, " +
+					"and used by later runs until it expires instead of signing in again. Runs that start at the same time share one sign-in. " +
+					"May also be provided via STORAGEGRID_CACHE_TOKEN environment variable. Defaults to false.",
+				Optional: true,
+			},
+			"auth_mode": schema.StringAttribute{
+				Description: "How the provider signs in to the tenant account: \"password\" signs in with username and password, " +
+					"\"sso\" signs in through the identity provider of a grid that enforces single sign-on, see saml_response_process. " +
+					"Tokens from SSO sign-ins are not renewed when they expire. May also be provided via STORAGEGRID_AUTH_MODE environment variable. Defaults to \"password\".",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authModePassword, authModeSSO),
+				},
+			},
+			"saml_response_process": schema.StringAttribute{
+				Description: "Command that signs in to the identity provider when auth_mode is \"sso\". The provider adds the identity provider sign-in URL, which carries the SAML request, as the last argument " +
+					"and passes username and password, when set, in the STORAGEGRID_USERNAME and STORAGEGRID_PASSWORD environment variables. " +
+					"The command prints the base64 encoded SAML response. It is split on whitespace and run without a shell. " +
+					"May also be provided via STORAGEGRID_SAML_RESPONSE_PROCESS environment variable.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path to a file with the tenant credentials, as a JSON object or INI style key = value lines with the keys accountid, username and password. " +
+					"Only used for values that are not set in the configuration or the environment. Conflicts with credential_process. May also be provided via STORAGEGRID_CREDENTIALS_FILE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("credential_process")),
+				},
+			},
+			"credential_process": schema.StringAttribute{
+				Description: "Command that prints the tenant credentials to standard output, in the same formats as credentials_file, such as a secret manager CLI. " +
+					"The command is split on whitespace and run without a shell. Only used for values that are not set in the configuration or the environment. " +
+					"May also be provided via STORAGEGRID_CREDENTIAL_PROCESS environment variable.",
+				Optional: true,
+			},
+			"s3_access_key": schema.StringAttribute{
+				Description: "Static S3 access key ID used for S3 operations instead of creating a temporary access key for the session. " +
+					"The provider never deletes this key. Requires s3_secret_key. May also be provided via STORAGEGRID_S3_ACCESS_KEY environment variable.",
+				Optional: true,
+			},
+			"s3_secret_key": schema.StringAttribute{
+				Description: "Secret of the static S3 access key, see s3_access_key. May also be provided via STORAGEGRID_S3_SECRET_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"s3_port": schema.Int64Attribute{
+				Description: "Port of the S3 API on the management host, used to derive the S3 endpoint when endpoints.s3 is not set. " +
+					"Without it, only a management endpoint on the default port 9443 is mapped to the default S3 port 10443.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust, in addition to the system roots, when connecting to the management and S3 endpoints. Conflicts with ca_cert_file.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded CA certificates to trust, in addition to the system roots, when connecting to the management and S3 endpoints. May also be provided via STORAGEGRID_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disables TLS certificate verification of the management and S3 endpoints. Only use this for testing. May also be provided via STORAGEGRID_INSECURE_SKIP_VERIFY environment variable. Defaults to false.",
+				Optional:    true,
+			},
+			"s3_ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust, in addition to the system roots, when connecting to the S3 endpoint. " +
+					"When any s3_ca_cert_pem, s3_ca_cert_file or s3_insecure_skip_verify setting is set, the S3 endpoint does not use ca_cert_pem, ca_cert_file and insecure_skip_verify. Conflicts with s3_ca_cert_file.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("s3_ca_cert_file")),
+				},
+			},
+			"s3_ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded CA certificates to trust, in addition to the system roots, when connecting to the S3 endpoint. May also be provided via STORAGEGRID_S3_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"s3_insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disables TLS certificate verification of the S3 endpoint. Only use this for testing. May also be provided via STORAGEGRID_S3_INSECURE_SKIP_VERIFY environment variable. Defaults to false.",
+				Optional:    true,
+			},
+			"s3_tls_server_name": schema.StringAttribute{
+				Description: "Server name sent with TLS SNI and verified against the certificate of the S3 endpoint, for S3 endpoints addressed by IP, such as a load balancer VIP, with certificates issued for a DNS name. " +
+					"Keeps the other TLS settings of the S3 endpoint. May also be provided via STORAGEGRID_S3_TLS_SERVER_NAME environment variable.",
+				Optional: true,
+			},
+			"s3_host_header": schema.StringAttribute{
+				Description: "Host header of S3 requests, signed in place of the host of the S3 endpoint, for load balancers that route S3 traffic by host name. " +
+					"May also be provided via STORAGEGRID_S3_HOST_HEADER environment variable.",
+				Optional: true,
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Description: "Maximum number of attempts for management and S3 API requests that fail with a transient error, see retry_status_codes, " +
+					"with exponential backoff and jitter between attempts. A Retry-After response header is respected. Set to 1 to disable retries. Defaults to 3.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_min_delay": schema.StringAttribute{
+				Description: "Delay before the first retry, as a Go duration string such as \"500ms\" or \"2s\". It doubles with every further attempt, up to retry_max_delay, " +
+					"and each delay is randomized between zero and its value. Defaults to 1s.",
+				Optional: true,
+			},
+			"retry_max_delay": schema.StringAttribute{
+				Description: "Maximum delay between attempts, also applied to Retry-After response headers, as a Go duration string such as \"1m\". " +
+					"Raise it for grids that are unavailable for a while during maintenance windows. Defaults to 30s.",
+				Optional: true,
+			},
+			"retry_status_codes": schema.ListAttribute{
+				Description: "Response statuses that are retried, replacing the default of 429, 500, 502, 503 and 504. Requests that are not safe to repeat, such as POST, " +
+					"are only retried for 429 and 503. S3 requests also retry the errors the AWS SDK retries by default. Set to an empty list to only retry those.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"default_region": schema.StringAttribute{
+				Description: "Region of storagegrid_s3_bucket resources that do not set region. Changing it does not affect existing buckets. " +
+					"When unset, buckets without a region are created in the default region of StorageGrid. May also be provided via STORAGEGRID_DEFAULT_REGION environment variable.",
+				Optional: true,
+			},
+			"audit_log_path": schema.StringAttribute{
+				Description: "File that a JSON line per management and S3 API request is appended to, with method, URL, status and duration. " +
+					"Bodies are recorded as the SHA-256 of their redacted form, so the file holds no credentials. May also be provided via STORAGEGRID_AUDIT_LOG_PATH environment variable.",
+				Optional: true,
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Headers set on every management and S3 API request, for API gateways that route or audit requests by header. " +
+					"Authorization, Content-Type, Content-Length, Host and User-Agent cannot be set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive("Authorization", "Content-Type", "Content-Length", "Host", "User-Agent")),
+				},
+			},
+			"log_curl_commands": schema.BoolAttribute{
+				Description: "Log every management API request as an equivalent curl command at DEBUG level, to reproduce requests by hand. " +
+					"The bearer token is replaced by $STORAGEGRID_TOKEN and passwords, tokens and keys in bodies are masked. " +
+					"May also be provided via STORAGEGRID_LOG_CURL_COMMANDS environment variable. Defaults to false.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum sustained rate of management API requests, shared by all resources and data sources. " +
+					"Requests beyond the rate wait for their turn instead of being throttled by StorageGrid. Defaults to 0, which disables rate limiting.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				Description: "Number of management API requests that may be sent at once before requests_per_second applies. Defaults to requests_per_second rounded up.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("requests_per_second")),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of management and S3 API requests in flight at the same time, regardless of Terraform's -parallelism. " +
+					"Lower it if StorageGrid returns 503 errors under load. Defaults to unlimited.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				Description: "Number of idle connections kept open to each of the management and S3 endpoints, so that later requests do not open a new TLS connection. Defaults to 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_conns_per_host": schema.Int64Attribute{
+				Description: "Maximum number of connections to each of the management and S3 endpoints, including those in use. " +
+					"Requests beyond the limit wait for a connection. Defaults to unlimited.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"idle_conn_timeout": schema.StringAttribute{
+				Description: "How long an idle connection is kept open, as a Go duration string such as \"30s\" or \"5m\". Defaults to 90s.",
+				Optional:    true,
+			},
+			"bucket_cache_ttl": schema.StringAttribute{
+				Description: "How long the bucket list fetched from the management API is cached, as a Go duration string such as \"30s\" or \"10m\". " +
+					"Bucket reads within this time share a single list request. Set to \"session\" to keep the list until the provider changes a bucket, " +
+					"so that a plan or refresh of many buckets makes a single list request, or to \"0s\" to disable the cache. Defaults to 5m.",
+				Optional: true,
+			},
+			"bucket_list_include": schema.ListAttribute{
+				Description: "Additional fields loaded with the cached bucket list, so that reads of them do not need a request per bucket. " +
+					"Valid values are \"deleteObjectStatus\" and \"crossGridReplication\". The region, compliance and object lock settings are always loaded.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(utils.BucketListIncludeOptions...)),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "When true, every create, update and delete fails with an error, while data sources, refresh and import still work. " +
+					"Use it to run drift detection against production tenants without any risk of changes to managed objects. " +
+					"Reading S3 bucket sub-configurations still creates the temporary access key the provider uses for S3 requests. May also be provided via STORAGEGRID_READ_ONLY environment variable. Defaults to false.",
+				Optional: true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "When false, the provider requests the tenant configuration while it is configured, so that an unreachable endpoint or a rejected token " +
+					"is reported with the endpoint and account instead of failing the first resource. Set to true to skip the request. " +
+					"May also be provided via STORAGEGRID_SKIP_CREDENTIALS_VALIDATION environment variable. Defaults to false.",
+				Optional: true,
+			},
+			"skip_s3_action_validation": schema.BoolAttribute{
+				Description: "When false, plans warn about actions in group and bucket policies that StorageGrid does not implement, as StorageGrid accepts such policies " +
+					"but ignores those actions. Set to true to turn off the warnings, e.g. for policies shared with other S3 platforms. Defaults to false.",
+				Optional: true,
+			},
+			"max_access_key_lifetime_days": schema.Int64Attribute{
+				Description: "Maximum number of days in the future that storagegrid_access_keys resources may set `expires` to. " +
+					"When set, access keys without an expiration or expiring later are rejected at plan time. Existing keys are only checked when they are replaced.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": schema.SingleNestedBlock{
+				Description: "Per-request timeouts for the management and S3 APIs, as Go duration strings such as \"30s\" or \"10m\". Read, write and delete default to 60s.",
+				Attributes: map[string]schema.Attribute{
+					"read": schema.StringAttribute{
+						Description: "Timeout for requests that read data (GET and HEAD).",
+						Optional:    true,
+					},
+					"write": schema.StringAttribute{
+						Description: "Timeout for requests that create or update data (POST and PUT).",
+						Optional:    true,
+					},
+					"delete": schema.StringAttribute{
+						Description: "Timeout for DELETE requests, such as deleting buckets that contain many objects.",
+						Optional:    true,
+					},
+					"s3_operation": schema.StringAttribute{
+						Description: "Timeout for an S3 operation, such as reading the lifecycle configuration of a bucket, including the retries of its requests. Defaults to 5m.",
+						Optional:    true,
+					},
+				},
+			},
+			"temporary_access_key": schema.SingleNestedBlock{
+				Description: "Temporary S3 access key that the provider creates for S3 operations when s3_access_key is not set.",
+				Attributes: map[string]schema.Attribute{
+					"lifetime": schema.StringAttribute{
+						Description: "How long the key is valid, as a Go duration string such as \"2h\" or \"24h\". Defaults to 2h.",
+						Optional:    true,
+					},
+					"display_name_prefix": schema.StringAttribute{
+						Description: "Prefix of the display name of the key, followed by its creation time, so that auditors can tell provider keys apart. By default, the key has no display name.",
+						Optional:    true,
+					},
+					"reuse": schema.BoolAttribute{
+						Description: "Keep the key when the provider exits and use it again in later runs while at least half of its lifetime remains, instead of creating a key for every run. " +
+							"The key and its secret are stored in the user cache directory, readable only by the current user. Defaults to false.",
+						Optional: true,
+					},
+				},
+			},
 			"endpoints": schema.SingleNestedBlock{
 				Description: "StorageGrid endpoint configuration for management and S3 APIs.",
 				Attributes: map[string]schema.Attribute{
 					"mgmt": schema.StringAttribute{
-						Description: "URI for StorageGrid management API. May also be provided via STORAGEGRID_ENDPOINT environment variable.",
+						Description: "URI for StorageGrid management API, such as https://storagegrid.example.com:9443. It must include the scheme and trailing slashes are ignored. May also be provided via STORAGEGRID_ENDPOINT environment variable.",
 						Required:    true,
 					},
 					"s3": schema.StringAttribute{
-						Description: "URI for StorageGrid S3 API. Required for S3 operations like bucket lifecycle configuration. May also be provided via STORAGEGRID_S3_ENDPOINT environment variable.",
+						Description: "URI for StorageGrid S3 API, used for S3 operations like bucket lifecycle configuration. When unset, it is derived from the management endpoint, see s3_port. May also be provided via STORAGEGRID_S3_ENDPOINT environment variable.",
 						Optional:    true,
 					},
 				},
 			},
+			"grid": schema.SingleNestedBlock{
+				Description: "Grid administrator credentials for managing grid-level objects, in addition to the tenant account configured above. Required by the storagegrid_tenant_accounts data source.",
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description: "URI for the grid management API, usually an admin node. Defaults to endpoints.mgmt. May also be provided via STORAGEGRID_GRID_ENDPOINT environment variable.",
+						Optional:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "Username of the grid administrator. May also be provided via STORAGEGRID_GRID_USERNAME environment variable.",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password of the grid administrator. May also be provided via STORAGEGRID_GRID_PASSWORD environment variable.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
 		},
 	}
 }
 
 func (p *StorageGridProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring StorageGrid client")
+	// Values that come from another module's outputs may only be known at apply time.
+	// When Terraform supports it, defer every resource and data source of this provider
+	// instead of failing the whole plan.
+	if !req.Config.Raw.IsFullyKnown() && req.ClientCapabilities.DeferralAllowed {
+		tflog.Info(ctx, "Provider configuration contains unknown values, deferring StorageGrid resources")
+		resp.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+		return
+	}
+
 	// Retrieve provider data from configuration
 	var config StorageGridProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -143,6 +540,203 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if config.Grid != nil && (config.Grid.Endpoint.IsUnknown() || config.Grid.Username.IsUnknown() || config.Grid.Password.IsUnknown()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grid"),
+			"Unknown StorageGrid Grid Administrator Configuration",
+			"The provider cannot create the StorageGrid grid API client as there is an unknown configuration value in the grid block. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the STORAGEGRID_GRID_ENDPOINT, STORAGEGRID_GRID_USERNAME and STORAGEGRID_GRID_PASSWORD environment variables.",
+		)
+	}
+
+	if config.S3Port.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("s3_port"),
+			"Unknown StorageGrid S3 Port",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for s3_port. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.Token.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown StorageGrid API Token",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for the StorageGrid API token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_TOKEN environment variable.",
+		)
+	}
+
+	if config.CACertPEM.IsUnknown() || config.CACertFile.IsUnknown() || config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown StorageGrid TLS Settings",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for ca_cert_pem, ca_cert_file or insecure_skip_verify. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_CA_CERT_FILE and STORAGEGRID_INSECURE_SKIP_VERIFY environment variables.",
+		)
+	}
+
+	if config.S3CACertPEM.IsUnknown() || config.S3CACertFile.IsUnknown() || config.S3InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown StorageGrid S3 TLS Settings",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for s3_ca_cert_pem, s3_ca_cert_file or s3_insecure_skip_verify. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_S3_CA_CERT_FILE and STORAGEGRID_S3_INSECURE_SKIP_VERIFY environment variables.",
+		)
+	}
+
+	if config.S3TLSServerName.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("s3_tls_server_name"),
+			"Unknown StorageGrid S3 TLS Server Name",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for s3_tls_server_name. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_S3_TLS_SERVER_NAME environment variable.",
+		)
+	}
+
+	if config.S3HostHeader.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("s3_host_header"),
+			"Unknown StorageGrid S3 Host Header",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for s3_host_header. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_S3_HOST_HEADER environment variable.",
+		)
+	}
+
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown StorageGrid Read-Only Setting",
+			"The provider cannot determine whether writes are allowed as there is an unknown configuration value for read_only. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_READ_ONLY environment variable.",
+		)
+	}
+
+	if config.CacheToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cache_token"),
+			"Unknown StorageGrid Token Cache Setting",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for cache_token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_CACHE_TOKEN environment variable.",
+		)
+	}
+
+	if config.SkipCredentialsValidation.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("skip_credentials_validation"),
+			"Unknown StorageGrid Credentials Validation Setting",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for skip_credentials_validation. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_SKIP_CREDENTIALS_VALIDATION environment variable.",
+		)
+	}
+
+	if config.SkipS3ActionValidation.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("skip_s3_action_validation"),
+			"Unknown StorageGrid S3 Action Validation Setting",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for skip_s3_action_validation. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxAccessKeyLifetimeDays.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_access_key_lifetime_days"),
+			"Unknown Maximum Access Key Lifetime",
+			"The provider cannot enforce the access key lifetime policy as there is an unknown configuration value for max_access_key_lifetime_days. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() || config.Burst.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown StorageGrid Rate Limit",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for requests_per_second or burst. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.AuthMode.IsUnknown() || config.SAMLResponseProcess.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown StorageGrid Authentication Mode",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for auth_mode or saml_response_process. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the STORAGEGRID_AUTH_MODE and STORAGEGRID_SAML_RESPONSE_PROCESS environment variables.",
+		)
+	}
+
+	if config.CredentialsFile.IsUnknown() || config.CredentialProcess.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown StorageGrid Credentials Source",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for credentials_file or credential_process. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the STORAGEGRID_CREDENTIALS_FILE and STORAGEGRID_CREDENTIAL_PROCESS environment variables.",
+		)
+	}
+
+	if config.S3AccessKey.IsUnknown() || config.S3SecretKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("s3_access_key"),
+			"Unknown StorageGrid S3 Credentials",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for s3_access_key or s3_secret_key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, "+
+				"or use the STORAGEGRID_S3_ACCESS_KEY and STORAGEGRID_S3_SECRET_KEY environment variables.",
+		)
+	}
+
+	if config.DefaultRegion.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_region"),
+			"Unknown StorageGrid Default Region",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for default_region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_DEFAULT_REGION environment variable.",
+		)
+	}
+
+	if config.AuditLogPath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("audit_log_path"),
+			"Unknown StorageGrid Audit Log Path",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for audit_log_path. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_AUDIT_LOG_PATH environment variable.",
+		)
+	}
+
+	if config.LogCurlCommands.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("log_curl_commands"),
+			"Unknown StorageGrid Curl Command Logging Setting",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for log_curl_commands. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STORAGEGRID_LOG_CURL_COMMANDS environment variable.",
+		)
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown StorageGrid Concurrent Request Limit",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for max_concurrent_requests. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxIdleConns.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_idle_conns"),
+			"Unknown StorageGrid Idle Connection Limit",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for max_idle_conns. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxConnsPerHost.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_conns_per_host"),
+			"Unknown StorageGrid Connection Limit",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for max_conns_per_host. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -154,6 +748,97 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 	accountID := os.Getenv("STORAGEGRID_ACCOUNTID")
 	username := os.Getenv("STORAGEGRID_USERNAME")
 	password := os.Getenv("STORAGEGRID_PASSWORD")
+	token := os.Getenv("STORAGEGRID_TOKEN")
+	authMode := os.Getenv("STORAGEGRID_AUTH_MODE")
+	samlResponseCommand := os.Getenv("STORAGEGRID_SAML_RESPONSE_PROCESS")
+	credentialsFile := os.Getenv("STORAGEGRID_CREDENTIALS_FILE")
+	credentialProcess := os.Getenv("STORAGEGRID_CREDENTIAL_PROCESS")
+	s3AccessKey := os.Getenv("STORAGEGRID_S3_ACCESS_KEY")
+	auditLogPath := os.Getenv("STORAGEGRID_AUDIT_LOG_PATH")
+	defaultRegion := os.Getenv("STORAGEGRID_DEFAULT_REGION")
+	s3SecretKey := os.Getenv("STORAGEGRID_S3_SECRET_KEY")
+	caCertFile := os.Getenv("STORAGEGRID_CA_CERT_FILE")
+	insecureSkipVerify := false
+	if v := os.Getenv("STORAGEGRID_INSECURE_SKIP_VERIFY"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid STORAGEGRID_INSECURE_SKIP_VERIFY Value",
+				fmt.Sprintf("The STORAGEGRID_INSECURE_SKIP_VERIFY environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		insecureSkipVerify = parsed
+	}
+	s3CACertFile := os.Getenv("STORAGEGRID_S3_CA_CERT_FILE")
+	var s3InsecureSkipVerify *bool
+	if v := os.Getenv("STORAGEGRID_S3_INSECURE_SKIP_VERIFY"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("s3_insecure_skip_verify"),
+				"Invalid STORAGEGRID_S3_INSECURE_SKIP_VERIFY Value",
+				fmt.Sprintf("The STORAGEGRID_S3_INSECURE_SKIP_VERIFY environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		s3InsecureSkipVerify = &parsed
+	}
+	s3TLSServerName := os.Getenv("STORAGEGRID_S3_TLS_SERVER_NAME")
+	s3HostHeader := os.Getenv("STORAGEGRID_S3_HOST_HEADER")
+	readOnly := false
+	if v := os.Getenv("STORAGEGRID_READ_ONLY"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid STORAGEGRID_READ_ONLY Value",
+				fmt.Sprintf("The STORAGEGRID_READ_ONLY environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		readOnly = parsed
+	}
+	cacheToken := false
+	if v := os.Getenv("STORAGEGRID_CACHE_TOKEN"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cache_token"),
+				"Invalid STORAGEGRID_CACHE_TOKEN Value",
+				fmt.Sprintf("The STORAGEGRID_CACHE_TOKEN environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		cacheToken = parsed
+	}
+	logCurlCommands := false
+	if v := os.Getenv("STORAGEGRID_LOG_CURL_COMMANDS"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("log_curl_commands"),
+				"Invalid STORAGEGRID_LOG_CURL_COMMANDS Value",
+				fmt.Sprintf("The STORAGEGRID_LOG_CURL_COMMANDS environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		logCurlCommands = parsed
+	}
+	skipCredentialsValidation := false
+	if v := os.Getenv("STORAGEGRID_SKIP_CREDENTIALS_VALIDATION"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("skip_credentials_validation"),
+				"Invalid STORAGEGRID_SKIP_CREDENTIALS_VALIDATION Value",
+				fmt.Sprintf("The STORAGEGRID_SKIP_CREDENTIALS_VALIDATION environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		skipCredentialsValidation = parsed
+	}
 
 	// Override with configuration values if provided
 	if config.Endpoints != nil {
@@ -177,6 +862,152 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 		password = config.Password.ValueString()
 	}
 
+	if !config.Token.IsNull() {
+		token = config.Token.ValueString()
+	}
+
+	if !config.AuthMode.IsNull() {
+		authMode = config.AuthMode.ValueString()
+	}
+
+	if !config.SAMLResponseProcess.IsNull() {
+		samlResponseCommand = config.SAMLResponseProcess.ValueString()
+	}
+
+	if authMode != "" && authMode != authModePassword && authMode != authModeSSO {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_mode"),
+			"Invalid STORAGEGRID_AUTH_MODE Value",
+			fmt.Sprintf("The STORAGEGRID_AUTH_MODE environment variable must be %q or %q, got %q.", authModePassword, authModeSSO, authMode),
+		)
+		return
+	}
+
+	if !config.CredentialsFile.IsNull() {
+		credentialsFile = config.CredentialsFile.ValueString()
+	}
+
+	if !config.CredentialProcess.IsNull() {
+		credentialProcess = config.CredentialProcess.ValueString()
+	}
+
+	// Resolve the credentials that are neither configured nor set in the environment
+	if token == "" && (accountID == "" || username == "" || password == "") && (credentialsFile != "" || credentialProcess != "") {
+		var creds tenantCredentials
+		var err error
+		if credentialsFile != "" {
+			creds, err = readCredentialsFile(credentialsFile)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("credentials_file"),
+					"Unable to Read StorageGrid Credentials File",
+					fmt.Sprintf("Could not read credentials file %s: %s", credentialsFile, err.Error()),
+				)
+				return
+			}
+		} else {
+			creds, err = runCredentialProcess(ctx, credentialProcess)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("credential_process"),
+					"Unable to Run StorageGrid Credential Process",
+					"The credential process failed: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		if accountID == "" {
+			accountID = creds.AccountID
+		}
+		if username == "" {
+			username = creds.Username
+		}
+		if password == "" {
+			password = creds.Password
+		}
+	}
+
+	if !config.S3AccessKey.IsNull() {
+		s3AccessKey = config.S3AccessKey.ValueString()
+	}
+
+	if !config.AuditLogPath.IsNull() {
+		auditLogPath = config.AuditLogPath.ValueString()
+	}
+
+	if !config.DefaultRegion.IsNull() {
+		defaultRegion = config.DefaultRegion.ValueString()
+	}
+
+	if !config.S3SecretKey.IsNull() {
+		s3SecretKey = config.S3SecretKey.ValueString()
+	}
+
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
+	if !config.CacheToken.IsNull() {
+		cacheToken = config.CacheToken.ValueBool()
+	}
+
+	if !config.LogCurlCommands.IsNull() {
+		logCurlCommands = config.LogCurlCommands.ValueBool()
+	}
+
+	if !config.SkipCredentialsValidation.IsNull() {
+		skipCredentialsValidation = config.SkipCredentialsValidation.ValueBool()
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if !config.S3CACertFile.IsNull() {
+		s3CACertFile = config.S3CACertFile.ValueString()
+	}
+
+	if !config.S3InsecureSkipVerify.IsNull() {
+		s3InsecureSkipVerify = config.S3InsecureSkipVerify.ValueBoolPointer()
+	}
+
+	if !config.S3TLSServerName.IsNull() {
+		s3TLSServerName = config.S3TLSServerName.ValueString()
+	}
+
+	if !config.S3HostHeader.IsNull() {
+		s3HostHeader = config.S3HostHeader.ValueString()
+	}
+
+	caCertPEM, diags := loadCACertPEM(config.CACertPEM.ValueString(), caCertFile, path.Root("ca_cert_file"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The S3 endpoint only gets its own TLS settings when at least one is set
+	var s3TLS *utils.TLSOptions
+	if !config.S3CACertPEM.IsNull() || s3CACertFile != "" || s3InsecureSkipVerify != nil {
+		s3CACertPEM, diags := loadCACertPEM(config.S3CACertPEM.ValueString(), s3CACertFile, path.Root("s3_ca_cert_file"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		s3TLS = &utils.TLSOptions{CACertPEM: s3CACertPEM, InsecureSkipVerify: s3InsecureSkipVerify != nil && *s3InsecureSkipVerify}
+	}
+	// A server name alone keeps the TLS settings of the management endpoint
+	if s3TLSServerName != "" {
+		if s3TLS == nil {
+			s3TLS = &utils.TLSOptions{CACertPEM: caCertPEM, InsecureSkipVerify: insecureSkipVerify}
+		}
+		s3TLS.ServerName = s3TLSServerName
+	}
+
 	// Validate required configurations (mgmt endpoint is required, S3 is optional)
 	if mgmtEndpoint == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -188,36 +1019,67 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
-	if accountID == "" {
+	if mgmtEndpoint != "" {
+		mgmtEndpoint, diags = normalizeEndpoint(mgmtEndpoint, path.Root("endpoints").AtName("mgmt"))
+		resp.Diagnostics.Append(diags...)
+	}
+	if s3Endpoint != "" {
+		s3Endpoint, diags = normalizeEndpoint(s3Endpoint, path.Root("endpoints").AtName("s3"))
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// The sign-in credentials are only required without a pre-issued token
+	if accountID == "" && token == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("accountid"),
 			"Missing StorageGrid API AccountID",
 			"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API accountID. "+
-				"Set the accountID value in the configuration or use the STORAGEGRID_ACCOUNTID environment variable. "+
+				"Set the accountID value in the configuration or use the STORAGEGRID_ACCOUNTID environment variable, or provide a token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if username == "" {
+	// With SSO, the username and password are only passed on to saml_response_process
+	if authMode == authModeSSO && token == "" && samlResponseCommand == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("saml_response_process"),
+			"Missing StorageGrid SAML Response Process",
+			"The provider cannot sign in with SSO as there is a missing or empty value for saml_response_process. "+
+				"Set the saml_response_process value in the configuration or use the STORAGEGRID_SAML_RESPONSE_PROCESS environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if username == "" && token == "" && authMode != authModeSSO {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing StorageGrid API Username",
 			"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API username. "+
-				"Set the username value in the configuration or use the STORAGEGRID_USERNAME environment variable. "+
+				"Set the username value in the configuration or use the STORAGEGRID_USERNAME environment variable, or provide a token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if password == "" {
+	if password == "" && token == "" && authMode != authModeSSO {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing StorageGrid API Password",
 			"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API password. "+
-				"Set the password value in the configuration or use the STORAGEGRID_PASSWORD environment variable. "+
+				"Set the password value in the configuration or use the STORAGEGRID_PASSWORD environment variable, or provide a token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	if (s3AccessKey == "") != (s3SecretKey == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("s3_secret_key"),
+			"Incomplete StorageGrid S3 Credentials",
+			"The provider cannot use static S3 credentials as only one of s3_access_key and s3_secret_key is set. "+
+				"Set both values in the configuration or use the STORAGEGRID_S3_ACCESS_KEY and STORAGEGRID_S3_SECRET_KEY environment variables, "+
+				"or set neither to let the provider create a temporary access key.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -226,8 +1088,10 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 	if s3Endpoint != "" {
 		ctx = tflog.SetField(ctx, "storagegrid_s3_endpoint", s3Endpoint)
 	}
-	ctx = tflog.SetField(ctx, "storagegrid_account_id", accountID)
-	ctx = tflog.SetField(ctx, "storagegrid_username", username)
+	if token == "" {
+		ctx = tflog.SetField(ctx, "storagegrid_account_id", accountID)
+		ctx = tflog.SetField(ctx, "storagegrid_username", username)
+	}
 
 	tflog.Debug(ctx, "Creating StorageGrid client")
 	// Pass nil for s3Endpoint if empty - the client will handle the optional S3 endpoint
@@ -236,7 +1100,68 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 		s3EndpointPtr = &s3Endpoint
 	}
 
-	client, err := utils.NewClient(&mgmtEndpoint, s3EndpointPtr, &accountID, &username, &password)
+	if config.RetryMaxAttempts.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_attempts"),
+			"Unknown StorageGrid Retry Attempts",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for retry_max_attempts. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return
+	}
+
+	timeouts, diags := parseProviderTimeouts(config.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	retry, diags := parseRetryOptions(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	bucketCacheTTL, diags := parseBucketCacheTTL(config.BucketCacheTTL)
+	resp.Diagnostics.Append(diags...)
+	bucketListInclude, diags := parseBucketListInclude(ctx, config.BucketListInclude)
+	resp.Diagnostics.Append(diags...)
+	temporaryKey, diags := parseTemporaryAccessKey(config.TemporaryAccessKey)
+	resp.Diagnostics.Append(diags...)
+	extraHeaders, diags := parseExtraHeaders(config.ExtraHeaders)
+	resp.Diagnostics.Append(diags...)
+	idleConnTimeout, diags := parseIdleConnTimeout(config.IdleConnTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientOptions := utils.ClientOptions{
+		CACertPEM:          caCertPEM,
+		InsecureSkipVerify: insecureSkipVerify,
+		S3TLS:              s3TLS,
+		S3HostHeader:       s3HostHeader,
+		Timeouts:           timeouts,
+		Retry:              retry,
+		Token:              token,
+		S3AccessKey:        s3AccessKey,
+		S3SecretKey:        s3SecretKey,
+		TemporaryKey:       temporaryKey,
+		BucketCacheTTL:     bucketCacheTTL,
+		BucketListInclude:  bucketListInclude,
+		AuditLogPath:       auditLogPath,
+		UserAgent:          userAgent(p.version, req.TerraformVersion),
+		ExtraHeaders:       extraHeaders,
+		CacheToken:         cacheToken,
+		LogCurlCommands:    logCurlCommands,
+		RateLimit: utils.RateLimit{
+			RequestsPerSecond: config.RequestsPerSecond.ValueFloat64(),
+			Burst:             int(config.Burst.ValueInt64()),
+		},
+		MaxConcurrentRequests: int(config.MaxConcurrentRequests.ValueInt64()),
+		Connections: utils.ConnectionOptions{
+			MaxIdleConns:    int(config.MaxIdleConns.ValueInt64()),
+			MaxConnsPerHost: int(config.MaxConnsPerHost.ValueInt64()),
+			IdleConnTimeout: idleConnTimeout,
+		},
+	}
+	if authMode == authModeSSO {
+		clientOptions.SAMLResponse = samlResponseProcess(samlResponseCommand, username, password)
+	}
+
+	client, err := utils.NewClient(ctx, &mgmtEndpoint, s3EndpointPtr, &accountID, &username, &password, clientOptions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create StorageGrid API Client",
@@ -247,10 +1172,53 @@ func (p *StorageGridProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Make the StorageGrid client available during DataSource and Resource
+	if client.APIVersion() < utils.SupportedAPIVersions[0] {
+		resp.Diagnostics.AddWarning(
+			"Older StorageGrid Management API Version",
+			fmt.Sprintf("The management endpoint does not offer API v%d, so the provider uses v%d. "+
+				"The provider is developed against v%d and some attributes may be missing or rejected by this grid.",
+				utils.SupportedAPIVersions[0], client.APIVersion(), utils.SupportedAPIVersions[0]),
+		)
+	}
+
+	if !skipCredentialsValidation {
+		if err := client.ValidateCredentials(ctx); err != nil {
+			account := "the tenant account"
+			if accountID != "" {
+				account = "tenant account " + accountID
+			}
+			resp.Diagnostics.AddError(
+				"Unable to Validate StorageGrid Credentials",
+				fmt.Sprintf("The provider could not read the configuration of %s from the management endpoint %s. "+
+					"Check the endpoint, the credentials and that the user may sign in to the Tenant Manager, or set skip_credentials_validation to skip this check.\n\n"+
+					"StorageGrid Client Error: %s", account, mgmtEndpoint, err),
+			)
+			return
+		}
+	}
+
+	client.ReadOnly = readOnly
+	client.DefaultRegion = defaultRegion
+	client.SkipS3ActionValidation = config.SkipS3ActionValidation.ValueBool()
+	client.S3Port = int(config.S3Port.ValueInt64())
+	if !config.MaxAccessKeyLifetimeDays.IsNull() {
+		client.MaxAccessKeyLifetime = time.Duration(config.MaxAccessKeyLifetimeDays.ValueInt64()) * 24 * time.Hour
+	}
+
+	gridClient, diags := configureGridClient(ctx, config.Grid, mgmtEndpoint, clientOptions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if gridClient != nil {
+		gridClient.ReadOnly = readOnly
+	}
+
+	// Make the StorageGrid clients available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &ProviderData{Client: client, Grid: gridClient}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 
 	tflog.Info(ctx, "Configured StorageGrid client", map[string]any{"success": true})
 }
@@ -259,21 +1227,43 @@ func (p *StorageGridProvider) Resources(_ context.Context) []func() resource.Res
 	return []func() resource.Resource{
 		NewGroupResource,
 		NewUserResource,
+		NewUserPasswordResource,
 		NewAccessKeysResource,
 		NewS3BucketResource,
 		NewS3BucketVersioningResource,
 		NewS3BucketObjectLockConfigurationResource,
 		NewS3BucketLifecycleConfigurationResource,
+		NewS3BucketPolicyResource,
+		NewS3BucketCORSConfigurationResource,
+		NewS3BucketTaggingResource,
+		NewS3BucketConsistencyResource,
+		NewS3BucketNotificationResource,
+		NewS3BucketComplianceResource,
+		NewS3BucketCrossGridReplicationResource,
+		NewS3ObjectLockSettingsResource,
+		NewTenantAccountProfileResource,
+		NewTenantGridFederationConnectionResource,
 	}
 }
 func (p *StorageGridProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewGroupURNDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewS3BucketDataSource,
 		NewS3BucketVersioningDataSource,
 		NewS3BucketObjectLockConfigurationDataSource,
 		NewS3BucketLifecycleConfigurationDataSource,
+		NewS3BucketCORSConfigurationDataSource,
+		NewS3BucketPlatformServicesDataSource,
+		NewTenantQuotaUtilizationDataSource,
+		NewS3EndpointCheckDataSource,
+		NewSwiftContainerDataSource,
+		NewSwiftContainersDataSource,
+		NewS3ObjectLockSettingsDataSource,
+		NewTenantAccountsDataSource,
 	}
 }
 
@@ -284,3 +1274,442 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// checkReadOnly returns an error when the provider is configured with read_only, so
+// resources refuse to change anything while data sources and refresh keep working.
+func checkReadOnly(client *utils.Client, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client != nil && client.ReadOnly {
+		diags.AddError(
+			"Provider Is Read-Only",
+			fmt.Sprintf("The StorageGrid provider is configured with read_only = true, so the resource cannot be %sd. "+
+				"Unset read_only (or STORAGEGRID_READ_ONLY) to apply changes.", action),
+		)
+	}
+	return diags
+}
+
+// checkGridClient returns an error when the provider has no grid administrator client,
+// for the resources and data sources that use the grid management API. usage describes
+// what the caller does, such as "lists the tenant accounts of the grid".
+func checkGridClient(grid *utils.Client, typeName, usage string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if grid == nil {
+		diags.AddError(
+			"Grid Administrator Credentials Required",
+			fmt.Sprintf("%s %s, which requires grid administrator credentials. "+
+				"Configure the grid block of the provider, or the STORAGEGRID_GRID_USERNAME and STORAGEGRID_GRID_PASSWORD environment variables.", typeName, usage),
+		)
+	}
+	return diags
+}
+
+// checkDeletionProtection returns an error when deletion_protection is set in the state
+// of a resource, so it is only deleted once the protection has been disabled and applied.
+func checkDeletionProtection(protected types.Bool, resourceType, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if protected.ValueBool() {
+		diags.AddError(
+			"Deletion Protection Is Enabled",
+			fmt.Sprintf("The %s %s has deletion_protection enabled and cannot be deleted or replaced. "+
+				"Set deletion_protection to false and apply the change before destroying it.", resourceType, name),
+		)
+	}
+	return diags
+}
+
+// parseProviderTimeouts converts the timeouts block into client timeouts. Unset values
+// are left at zero so the client applies its defaults.
+func parseProviderTimeouts(config *ProviderTimeoutsModel) (utils.Timeouts, diag.Diagnostics) {
+	var timeouts utils.Timeouts
+	var diags diag.Diagnostics
+	if config == nil {
+		return timeouts, diags
+	}
+
+	for name, field := range map[string]struct {
+		value  types.String
+		target *time.Duration
+	}{
+		"read":         {config.Read, &timeouts.Read},
+		"write":        {config.Write, &timeouts.Write},
+		"delete":       {config.Delete, &timeouts.Delete},
+		"s3_operation": {config.S3Operation, &timeouts.S3Operation},
+	} {
+		if field.value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root("timeouts").AtName(name),
+				"Unknown StorageGrid Timeout",
+				"The provider cannot create the StorageGrid API client as there is an unknown configuration value for the timeout. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+			continue
+		}
+		if field.value.IsNull() {
+			continue
+		}
+
+		duration, err := time.ParseDuration(field.value.ValueString())
+		if err != nil || duration <= 0 {
+			diags.AddAttributeError(
+				path.Root("timeouts").AtName(name),
+				"Invalid StorageGrid Timeout",
+				fmt.Sprintf("The timeout must be a positive duration such as \"30s\" or \"10m\", got %q.", field.value.ValueString()),
+			)
+			continue
+		}
+		*field.target = duration
+	}
+
+	return timeouts, diags
+}
+
+// parseRetryOptions converts the retry settings of the provider configuration into the
+// client retry options. Unset values are left at zero so the client applies its defaults.
+func parseRetryOptions(ctx context.Context, config StorageGridProviderModel) (utils.RetryOptions, diag.Diagnostics) {
+	retry := utils.RetryOptions{MaxAttempts: int(config.RetryMaxAttempts.ValueInt64())}
+	var diags diag.Diagnostics
+
+	for name, field := range map[string]struct {
+		value  types.String
+		target *time.Duration
+	}{
+		"retry_min_delay": {config.RetryMinDelay, &retry.BaseDelay},
+		"retry_max_delay": {config.RetryMaxDelay, &retry.MaxDelay},
+	} {
+		if field.value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Unknown StorageGrid Retry Delay",
+				fmt.Sprintf("The provider cannot create the StorageGrid API client as there is an unknown configuration value for %s. ", name)+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+			continue
+		}
+		if field.value.IsNull() {
+			continue
+		}
+
+		delay, err := time.ParseDuration(field.value.ValueString())
+		if err != nil || delay <= 0 {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid StorageGrid Retry Delay",
+				fmt.Sprintf("The retry delay must be a positive duration such as \"500ms\" or \"30s\", got %q.", field.value.ValueString()),
+			)
+			continue
+		}
+		*field.target = delay
+	}
+	if retry.BaseDelay > 0 && retry.MaxDelay > 0 && retry.BaseDelay > retry.MaxDelay {
+		diags.AddAttributeError(
+			path.Root("retry_min_delay"),
+			"Invalid StorageGrid Retry Delay",
+			fmt.Sprintf("retry_min_delay (%s) must not be longer than retry_max_delay (%s).", retry.BaseDelay, retry.MaxDelay),
+		)
+	}
+
+	if config.RetryStatusCodes.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("retry_status_codes"),
+			"Unknown StorageGrid Retry Status Codes",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for retry_status_codes. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	} else if !config.RetryStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(config.RetryStatusCodes.ElementsAs(ctx, &codes, false)...)
+		retry.StatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			retry.StatusCodes = append(retry.StatusCodes, int(code))
+		}
+	}
+
+	return retry, diags
+}
+
+// configureGridClient signs in as a grid administrator when grid credentials are set in
+// the grid block or the environment. It returns a nil client when they are not.
+func configureGridClient(ctx context.Context, config *GridModel, mgmtEndpoint string, opts utils.ClientOptions) (*utils.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpoint := os.Getenv("STORAGEGRID_GRID_ENDPOINT")
+	username := os.Getenv("STORAGEGRID_GRID_USERNAME")
+	password := os.Getenv("STORAGEGRID_GRID_PASSWORD")
+	if config != nil {
+		if !config.Endpoint.IsNull() {
+			endpoint = config.Endpoint.ValueString()
+		}
+		if !config.Username.IsNull() {
+			username = config.Username.ValueString()
+		}
+		if !config.Password.IsNull() {
+			password = config.Password.ValueString()
+		}
+	}
+
+	if config == nil && username == "" && password == "" {
+		return nil, diags
+	}
+	if endpoint == "" {
+		endpoint = mgmtEndpoint
+	} else {
+		var endpointDiags diag.Diagnostics
+		endpoint, endpointDiags = normalizeEndpoint(endpoint, path.Root("grid").AtName("endpoint"))
+		diags.Append(endpointDiags...)
+	}
+
+	if username == "" {
+		diags.AddAttributeError(
+			path.Root("grid").AtName("username"),
+			"Missing StorageGrid Grid Administrator Username",
+			"The provider cannot create the StorageGrid grid API client as there is a missing or empty value for the grid administrator username. "+
+				"Set the grid.username value in the configuration or use the STORAGEGRID_GRID_USERNAME environment variable, or remove the grid block.",
+		)
+	}
+	if password == "" {
+		diags.AddAttributeError(
+			path.Root("grid").AtName("password"),
+			"Missing StorageGrid Grid Administrator Password",
+			"The provider cannot create the StorageGrid grid API client as there is a missing or empty value for the grid administrator password. "+
+				"Set the grid.password value in the configuration or use the STORAGEGRID_GRID_PASSWORD environment variable, or remove the grid block.",
+		)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// A pre-issued token and static S3 credentials are only valid for the tenant account
+	opts.Token = ""
+	opts.S3AccessKey = ""
+	opts.S3SecretKey = ""
+	// Grid administrators always sign in with a password
+	opts.SAMLResponse = nil
+
+	tflog.Debug(ctx, "Creating StorageGrid grid client", map[string]any{"storagegrid_grid_endpoint": endpoint, "storagegrid_grid_username": username})
+	client, err := utils.NewGridClient(ctx, endpoint, username, password, opts)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create StorageGrid Grid API Client",
+			"An unexpected error occurred when signing in as grid administrator. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"StorageGrid Client Error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return client, diags
+}
+
+// parseBucketCacheTTL converts the bucket_cache_ttl attribute to the client option, where
+// an explicit zero duration disables the cache and "session" keeps entries until invalidated.
+func parseBucketCacheTTL(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("bucket_cache_ttl"),
+			"Unknown StorageGrid Bucket Cache TTL",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for bucket_cache_ttl. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return 0, diags
+	}
+	if value.IsNull() {
+		return 0, diags
+	}
+	if value.ValueString() == "session" {
+		return utils.BucketCacheSession, diags
+	}
+
+	ttl, err := time.ParseDuration(value.ValueString())
+	if err != nil || ttl < 0 {
+		diags.AddAttributeError(
+			path.Root("bucket_cache_ttl"),
+			"Invalid StorageGrid Bucket Cache TTL",
+			fmt.Sprintf("The bucket cache TTL must be a duration such as \"30s\" or \"10m\", \"session\", or \"0s\" to disable the cache, got %q.", value.ValueString()),
+		)
+		return 0, diags
+	}
+	if ttl == 0 {
+		return -1, diags
+	}
+
+	return ttl, diags
+}
+
+// parseBucketListInclude converts the bucket_list_include attribute to the client option.
+func parseBucketListInclude(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("bucket_list_include"),
+			"Unknown StorageGrid Bucket List Include",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for bucket_list_include. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return nil, diags
+	}
+	if value.IsNull() {
+		return nil, diags
+	}
+
+	var include []string
+	diags.Append(value.ElementsAs(ctx, &include, false)...)
+	return include, diags
+}
+
+// parseIdleConnTimeout parses the idle_conn_timeout of the provider configuration. Null
+// returns zero so the client applies its default.
+func parseIdleConnTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("idle_conn_timeout"),
+			"Unknown StorageGrid Idle Connection Timeout",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for idle_conn_timeout. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return 0, diags
+	}
+	if value.IsNull() {
+		return 0, diags
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		diags.AddAttributeError(
+			path.Root("idle_conn_timeout"),
+			"Invalid StorageGrid Idle Connection Timeout",
+			fmt.Sprintf("The idle connection timeout must be a positive duration such as \"30s\" or \"5m\", got %q.", value.ValueString()),
+		)
+		return 0, diags
+	}
+
+	return timeout, diags
+}
+
+// normalizeEndpoint validates an endpoint URL and strips trailing slashes, which would
+// otherwise double the slash in front of the API paths. Plain http is allowed with a
+// warning.
+func normalizeEndpoint(endpoint string, attr path.Path) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		diags.AddAttributeError(
+			attr,
+			"Invalid StorageGrid Endpoint",
+			fmt.Sprintf("The endpoint must be an absolute URL with an https:// or http:// scheme and a host, and without a query or fragment, "+
+				"such as \"https://storagegrid.example.com:9443\", got %q.", endpoint),
+		)
+		return endpoint, diags
+	}
+	if u.Scheme == "http" {
+		diags.AddAttributeWarning(
+			attr,
+			"Insecure StorageGrid Endpoint",
+			fmt.Sprintf("The endpoint %s uses plain http, so credentials, tokens and data are sent unencrypted. Use https unless the connection is otherwise protected.", endpoint),
+		)
+	}
+
+	return strings.TrimRight(endpoint, "/"), diags
+}
+
+// loadCACertPEM returns the PEM encoded CA certificates of a TLS configuration, read from
+// file unless they are set inline. fileAttr is the attribute errors reading file refer to.
+func loadCACertPEM(inline, file string, fileAttr path.Path) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if inline != "" || file == "" {
+		return inline, diags
+	}
+
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		diags.AddAttributeError(
+			fileAttr,
+			"Unable to Read StorageGrid CA Certificate File",
+			fmt.Sprintf("Could not read CA certificate file %s: %s", file, err.Error()),
+		)
+		return "", diags
+	}
+	return string(pem), diags
+}
+
+// parseExtraHeaders returns the extra_headers of the provider configuration.
+func parseExtraHeaders(value types.Map) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("extra_headers"),
+			"Unknown StorageGrid Extra Headers",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value for extra_headers. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return nil, diags
+	}
+	if value.IsNull() {
+		return nil, diags
+	}
+
+	headers := make(map[string]string, len(value.Elements()))
+	for name, element := range value.Elements() {
+		header, ok := element.(types.String)
+		if !ok || header.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root("extra_headers").AtMapKey(name),
+				"Unknown StorageGrid Extra Header",
+				"The provider cannot create the StorageGrid API client as there is an unknown configuration value for extra_headers. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+			continue
+		}
+		headers[name] = header.ValueString()
+	}
+	return headers, diags
+}
+
+// userAgent returns the User-Agent of API requests, which names the provider and
+// Terraform versions.
+func userAgent(providerVersion, terraformVersion string) string {
+	ua := "terraform-provider-storagegrid/" + providerVersion
+	if terraformVersion != "" {
+		ua += " Terraform/" + terraformVersion
+	}
+	return ua
+}
+
+// parseTemporaryAccessKey converts the temporary_access_key block into the client options.
+func parseTemporaryAccessKey(config *TemporaryAccessKeyModel) (utils.TemporaryKeyOptions, diag.Diagnostics) {
+	var opts utils.TemporaryKeyOptions
+	var diags diag.Diagnostics
+	if config == nil {
+		return opts, diags
+	}
+
+	if config.Lifetime.IsUnknown() || config.DisplayNamePrefix.IsUnknown() || config.Reuse.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("temporary_access_key"),
+			"Unknown StorageGrid Temporary Access Key Configuration",
+			"The provider cannot create the StorageGrid API client as there is an unknown configuration value in the temporary_access_key block. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return opts, diags
+	}
+
+	if !config.Lifetime.IsNull() {
+		lifetime, err := time.ParseDuration(config.Lifetime.ValueString())
+		if err != nil || lifetime <= 0 {
+			diags.AddAttributeError(
+				path.Root("temporary_access_key").AtName("lifetime"),
+				"Invalid StorageGrid Temporary Access Key Lifetime",
+				fmt.Sprintf("The lifetime must be a positive duration such as \"2h\" or \"24h\", got %q.", config.Lifetime.ValueString()),
+			)
+			return opts, diags
+		}
+		opts.Lifetime = lifetime
+	}
+
+	opts.DisplayNamePrefix = config.DisplayNamePrefix.ValueString()
+	opts.Reuse = config.Reuse.ValueBool()
+	return opts, diags
+}
@@ -4,11 +4,21 @@
 package provider
 
 import (
+	"context"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -61,3 +71,269 @@ provider "storagegrid" {
   # - STORAGEGRID_PASSWORD
 }
 `
+
+func TestProviderConfigureDefersUnknownConfig(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	endpointsType := configType.AttributeTypes["endpoints"]
+
+	attributes := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+	attributes["endpoints"] = tftypes.NewValue(endpointsType, tftypes.UnknownValue)
+	attributes["accountid"] = tftypes.NewValue(tftypes.String, "12345")
+	attributes["username"] = tftypes.NewValue(tftypes.String, "admin")
+	attributes["password"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(configType, attributes),
+	}
+
+	tests := []struct {
+		name            string
+		deferralAllowed bool
+		wantDeferred    bool
+	}{
+		{name: "deferral allowed", deferralAllowed: true, wantDeferred: true},
+		{name: "deferral not allowed", deferralAllowed: false, wantDeferred: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := provider.ConfigureRequest{
+				Config:             config,
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: tt.deferralAllowed},
+			}
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, req, resp)
+
+			if got := resp.Deferred != nil; got != tt.wantDeferred {
+				t.Fatalf("Deferred = %v, want %v", got, tt.wantDeferred)
+			}
+			if tt.wantDeferred {
+				if resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+					t.Errorf("Deferred.Reason = %v, want %v", resp.Deferred.Reason, provider.DeferredReasonProviderConfigUnknown)
+				}
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+			} else if !resp.Diagnostics.HasError() {
+				t.Error("expected an error for unknown configuration values")
+			}
+		})
+	}
+}
+
+func TestCheckReadOnly(t *testing.T) {
+	if diags := checkReadOnly(&utils.Client{}, "create"); diags.HasError() {
+		t.Fatalf("writable client: unexpected diagnostics: %v", diags)
+	}
+	if diags := checkReadOnly(nil, "create"); diags.HasError() {
+		t.Fatalf("unconfigured client: unexpected diagnostics: %v", diags)
+	}
+	if diags := checkReadOnly(&utils.Client{ReadOnly: true}, "delete"); !diags.HasError() {
+		t.Fatal("read-only client: expected an error")
+	}
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	if diags := checkDeletionProtection(types.BoolValue(false), "bucket", "logs"); diags.HasError() {
+		t.Fatalf("unprotected: unexpected diagnostics: %v", diags)
+	}
+	if diags := checkDeletionProtection(types.BoolNull(), "bucket", "logs"); diags.HasError() {
+		t.Fatalf("unset: unexpected diagnostics: %v", diags)
+	}
+	if diags := checkDeletionProtection(types.BoolValue(true), "group", "admins"); !diags.HasError() {
+		t.Fatal("protected: expected an error")
+	}
+}
+
+func TestParseProviderTimeouts(t *testing.T) {
+	timeouts, diags := parseProviderTimeouts(&ProviderTimeoutsModel{
+		Read:        types.StringValue("30s"),
+		Write:       types.StringNull(),
+		Delete:      types.StringValue("15m"),
+		S3Operation: types.StringValue("10m"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := (utils.Timeouts{Read: 30 * time.Second, Delete: 15 * time.Minute, S3Operation: 10 * time.Minute}); timeouts != want {
+		t.Fatalf("timeouts = %#v, want %#v", timeouts, want)
+	}
+
+	if _, diags := parseProviderTimeouts(&ProviderTimeoutsModel{Read: types.StringValue("soon")}); !diags.HasError() {
+		t.Fatal("expected an error for an invalid duration")
+	}
+	if _, diags := parseProviderTimeouts(&ProviderTimeoutsModel{Write: types.StringValue("-1s")}); !diags.HasError() {
+		t.Fatal("expected an error for a negative duration")
+	}
+}
+
+func TestParseTemporaryAccessKey(t *testing.T) {
+	opts, diags := parseTemporaryAccessKey(&TemporaryAccessKeyModel{
+		Lifetime:          types.StringValue("24h"),
+		DisplayNamePrefix: types.StringValue("terraform-"),
+		Reuse:             types.BoolValue(true),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := (utils.TemporaryKeyOptions{Lifetime: 24 * time.Hour, DisplayNamePrefix: "terraform-", Reuse: true}); opts != want {
+		t.Fatalf("options = %#v, want %#v", opts, want)
+	}
+
+	if _, diags := parseTemporaryAccessKey(&TemporaryAccessKeyModel{Lifetime: types.StringValue("0s")}); !diags.HasError() {
+		t.Fatal("expected an error for a zero lifetime")
+	}
+}
+
+func TestParseBucketCacheTTL(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: types.StringNull(), want: 0},
+		{value: types.StringValue("30s"), want: 30 * time.Second},
+		{value: types.StringValue("0s"), want: -1},
+		{value: types.StringValue("session"), want: utils.BucketCacheSession},
+		{value: types.StringValue("-1m"), wantErr: true},
+		{value: types.StringValue("soon"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, diags := parseBucketCacheTTL(tt.value)
+		if diags.HasError() != tt.wantErr {
+			t.Fatalf("parseBucketCacheTTL(%s) diagnostics = %v, want error %t", tt.value, diags, tt.wantErr)
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Fatalf("parseBucketCacheTTL(%s) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseRetryOptions(t *testing.T) {
+	config := StorageGridProviderModel{
+		RetryMaxAttempts: types.Int64Value(5),
+		RetryMinDelay:    types.StringValue("500ms"),
+		RetryMaxDelay:    types.StringValue("2m"),
+		RetryStatusCodes: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(429), types.Int64Value(503)}),
+	}
+	got, diags := parseRetryOptions(t.Context(), config)
+	if diags.HasError() {
+		t.Fatalf("parseRetryOptions() diagnostics = %v", diags)
+	}
+	if got.MaxAttempts != 5 || got.BaseDelay != 500*time.Millisecond || got.MaxDelay != 2*time.Minute || !slices.Equal(got.StatusCodes, []int{429, 503}) {
+		t.Fatalf("parseRetryOptions() = %+v", got)
+	}
+
+	// Unset values are left to the client defaults
+	got, diags = parseRetryOptions(t.Context(), StorageGridProviderModel{RetryStatusCodes: types.ListNull(types.Int64Type)})
+	if diags.HasError() || got.BaseDelay != 0 || got.MaxDelay != 0 || got.StatusCodes != nil {
+		t.Fatalf("parseRetryOptions() = %+v, %v", got, diags)
+	}
+
+	config.RetryMinDelay = types.StringValue("5m")
+	if _, diags := parseRetryOptions(t.Context(), config); !diags.HasError() {
+		t.Fatal("expected an error for retry_min_delay longer than retry_max_delay")
+	}
+}
+
+func TestNormalizeEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint    string
+		want        string
+		wantErr     bool
+		wantWarning bool
+	}{
+		{endpoint: "https://storagegrid.example.com:9443", want: "https://storagegrid.example.com:9443"},
+		{endpoint: "https://storagegrid.example.com:9443/", want: "https://storagegrid.example.com:9443"},
+		{endpoint: "https://gateway.example.com/storagegrid//", want: "https://gateway.example.com/storagegrid"},
+		{endpoint: "http://storagegrid.example.com:9080", want: "http://storagegrid.example.com:9080", wantWarning: true},
+		{endpoint: "storagegrid.example.com:9443", wantErr: true},
+		{endpoint: "ftp://storagegrid.example.com", wantErr: true},
+		{endpoint: "https://storagegrid.example.com/?tenant=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, diags := normalizeEndpoint(tt.endpoint, path.Root("endpoints").AtName("mgmt"))
+		if diags.HasError() != tt.wantErr {
+			t.Fatalf("normalizeEndpoint(%q) diagnostics = %v, want error %t", tt.endpoint, diags, tt.wantErr)
+		}
+		if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+			t.Fatalf("normalizeEndpoint(%q) warnings = %v, want warning %t", tt.endpoint, diags.Warnings(), tt.wantWarning)
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Fatalf("normalizeEndpoint(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestParseIdleConnTimeout(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: types.StringNull(), want: 0},
+		{value: types.StringValue("2m"), want: 2 * time.Minute},
+		{value: types.StringValue("0s"), wantErr: true},
+		{value: types.StringValue("forever"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, diags := parseIdleConnTimeout(tt.value)
+		if diags.HasError() != tt.wantErr {
+			t.Fatalf("parseIdleConnTimeout(%s) diagnostics = %v, want error %t", tt.value, diags, tt.wantErr)
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Fatalf("parseIdleConnTimeout(%s) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseExtraHeaders(t *testing.T) {
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{"X-Gateway-Route": types.StringValue("storage-team")})
+	got, diags := parseExtraHeaders(headers)
+	if diags.HasError() {
+		t.Fatalf("parseExtraHeaders() diagnostics = %v", diags)
+	}
+	if len(got) != 1 || got["X-Gateway-Route"] != "storage-team" {
+		t.Fatalf("parseExtraHeaders() = %v", got)
+	}
+
+	unknown := types.MapValueMust(types.StringType, map[string]attr.Value{"X-Gateway-Route": types.StringUnknown()})
+	if _, diags := parseExtraHeaders(unknown); !diags.HasError() {
+		t.Fatal("expected an error for an unknown header value")
+	}
+	if got, diags := parseExtraHeaders(types.MapNull(types.StringType)); diags.HasError() || got != nil {
+		t.Fatalf("parseExtraHeaders(null) = %v, %v", got, diags)
+	}
+}
+
+func TestConfigureGridClientRequiresCredentials(t *testing.T) {
+	t.Setenv("STORAGEGRID_GRID_ENDPOINT", "")
+	t.Setenv("STORAGEGRID_GRID_USERNAME", "")
+	t.Setenv("STORAGEGRID_GRID_PASSWORD", "")
+
+	client, diags := configureGridClient(context.Background(), nil, "https://grid.example.com", utils.ClientOptions{})
+	if diags.HasError() || client != nil {
+		t.Fatalf("without a grid block: client = %v, diagnostics = %v, want neither", client, diags)
+	}
+
+	_, diags = configureGridClient(context.Background(), &GridModel{
+		Endpoint: types.StringNull(),
+		Username: types.StringValue("admin"),
+		Password: types.StringNull(),
+	}, "https://grid.example.com", utils.ClientOptions{})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing grid password")
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3BucketComplianceResource{}
+	_ resource.ResourceWithConfigure   = &S3BucketComplianceResource{}
+	_ resource.ResourceWithImportState = &S3BucketComplianceResource{}
+	_ resource.ResourceWithModifyPlan  = &S3BucketComplianceResource{}
+)
+
+func NewS3BucketComplianceResource() resource.Resource {
+	return &S3BucketComplianceResource{}
+}
+
+// S3BucketComplianceResource defines the resource implementation.
+type S3BucketComplianceResource struct {
+	client *utils.Client
+}
+
+// S3BucketComplianceResourceModel describes the resource data model.
+type S3BucketComplianceResourceModel struct {
+	BucketName             types.String `tfsdk:"bucket_name"`
+	RetentionPeriodMinutes types.Int64  `tfsdk:"retention_period_minutes"`
+	LegalHold              types.Bool   `tfsdk:"legal_hold"`
+	AutoDelete             types.Bool   `tfsdk:"auto_delete"`
+	ID                     types.String `tfsdk:"id"`
+}
+
+func (r *S3BucketComplianceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_compliance"
+}
+
+func (r *S3BucketComplianceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the legacy compliance settings of a StorageGrid S3 bucket that was created with legacy compliance enabled. " +
+			"New buckets should use S3 Object Lock instead. Compliance cannot be removed from a bucket, so destroying the resource keeps the current settings.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the legacy compliant S3 bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention_period_minutes": schema.Int64Attribute{
+				Description: "The length of the retention period of the objects of the bucket, in minutes. It can only be increased.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"legal_hold": schema.BoolAttribute{
+				Description: "Whether the bucket is under a legal hold, which prevents the deletion of its objects even after their retention period. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"auto_delete": schema.BoolAttribute{
+				Description: "Whether objects are deleted automatically when their retention period expires, unless the bucket is under a legal hold. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the compliance settings (same as bucket_name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *S3BucketComplianceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan rejects a decreased retention period at plan time, as StorageGrid only
+// allows it to be increased.
+func (r *S3BucketComplianceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planned, current types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("retention_period_minutes"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("retention_period_minutes"), &current)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() || planned.IsNull() || current.IsNull() {
+		return
+	}
+
+	if planned.ValueInt64() < current.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retention_period_minutes"),
+			"Retention Period Cannot Be Decreased",
+			fmt.Sprintf("The retention period of a legacy compliant bucket can only be increased, from %d minutes currently.", current.ValueInt64()),
+		)
+	}
+}
+
+// complianceConfig converts the Terraform model into the API model.
+func complianceConfig(model S3BucketComplianceResourceModel) utils.ComplianceConfig {
+	return utils.ComplianceConfig{
+		AutoDelete:             model.AutoDelete.ValueBool(),
+		LegalHold:              model.LegalHold.ValueBool(),
+		RetentionPeriodMinutes: model.RetentionPeriodMinutes.ValueInt64(),
+	}
+}
+
+func (r *S3BucketComplianceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.UpdateS3BucketCompliance(ctx, bucketName, complianceConfig(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Bucket Compliance Settings for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set the ID (same as bucket name)
+	plan.ID = types.StringValue(bucketName)
+
+	// Save the plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketComplianceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	compliance, err := r.client.GetS3BucketCompliance(ctx, bucketName)
+	if err != nil {
+		// The bucket was deleted outside of Terraform
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket Compliance Settings for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Update state with current values
+	state.RetentionPeriodMinutes = types.Int64Value(compliance.RetentionPeriodMinutes)
+	state.LegalHold = types.BoolValue(compliance.LegalHold)
+	state.AutoDelete = types.BoolValue(compliance.AutoDelete)
+	state.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3BucketComplianceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.UpdateS3BucketCompliance(ctx, bucketName, complianceConfig(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Bucket Compliance Settings for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the updated plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketComplianceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Legacy compliance cannot be disabled, so the bucket keeps its settings
+	resp.Diagnostics.AddWarning(
+		"Compliance Settings Remain on the Bucket",
+		fmt.Sprintf("Legacy compliance cannot be removed from bucket %s. The compliance resource has been removed from Terraform state, "+
+			"but the bucket keeps a retention period of %d minutes, legal hold %t and auto delete %t.",
+			state.BucketName.ValueString(), state.RetentionPeriodMinutes.ValueInt64(), state.LegalHold.ValueBool(), state.AutoDelete.ValueBool()),
+	)
+
+	// State is automatically cleared on successful delete
+}
+
+func (r *S3BucketComplianceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the bucket name as the identifier
+	bucketName := req.ID
+
+	compliance, err := r.client.GetS3BucketCompliance(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Bucket Compliance Settings for %s", bucketName),
+			fmt.Sprintf("Bucket does not exist or its compliance settings are not accessible: %s", err.Error()),
+		)
+		return
+	}
+
+	state := S3BucketComplianceResourceModel{
+		BucketName:             types.StringValue(bucketName),
+		RetentionPeriodMinutes: types.Int64Value(compliance.RetentionPeriodMinutes),
+		LegalHold:              types.BoolValue(compliance.LegalHold),
+		AutoDelete:             types.BoolValue(compliance.AutoDelete),
+		ID:                     types.StringValue(bucketName),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Set the ID attribute explicitly for import
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3BucketConsistencyResource{}
+	_ resource.ResourceWithConfigure   = &S3BucketConsistencyResource{}
+	_ resource.ResourceWithImportState = &S3BucketConsistencyResource{}
+)
+
+func NewS3BucketConsistencyResource() resource.Resource {
+	return &S3BucketConsistencyResource{}
+}
+
+// S3BucketConsistencyResource defines the resource implementation.
+type S3BucketConsistencyResource struct {
+	client *utils.Client
+}
+
+// S3BucketConsistencyResourceModel describes the resource data model.
+type S3BucketConsistencyResourceModel struct {
+	BucketName  types.String `tfsdk:"bucket_name"`
+	Consistency types.String `tfsdk:"consistency"`
+	ID          types.String `tfsdk:"id"`
+}
+
+func (r *S3BucketConsistencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_consistency"
+}
+
+func (r *S3BucketConsistencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the consistency level of a StorageGrid S3 bucket, which trades the availability of objects across sites for their consistency. " +
+			"Destroying the resource restores the default level " + utils.DefaultBucketConsistency + ".",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to set the consistency level for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"consistency": schema.StringAttribute{
+				Description: "The consistency level of the bucket. Valid values: " + strings.Join(utils.BucketConsistencyLevels, ", ") + ".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.BucketConsistencyLevels...),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the consistency configuration (same as bucket_name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *S3BucketConsistencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *S3BucketConsistencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketConsistencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.UpdateS3BucketConsistency(ctx, bucketName, plan.Consistency.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Bucket Consistency for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set the ID (same as bucket name)
+	plan.ID = types.StringValue(bucketName)
+
+	// Save the plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketConsistencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3BucketConsistencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	consistency, err := r.client.GetS3BucketConsistency(ctx, bucketName)
+	if err != nil {
+		// The bucket was deleted outside of Terraform
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket Consistency for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Update state with current values
+	state.Consistency = types.StringValue(consistency.Consistency)
+	state.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3BucketConsistencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketConsistencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.UpdateS3BucketConsistency(ctx, bucketName, plan.Consistency.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Bucket Consistency for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the updated plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketConsistencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3BucketConsistencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	// A bucket always has a consistency level, so deleting restores the default. A
+	// bucket that no longer exists counts as done.
+	err := r.client.UpdateS3BucketConsistency(ctx, bucketName, utils.DefaultBucketConsistency)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete S3 Bucket Consistency for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// State is automatically cleared on successful delete
+}
+
+func (r *S3BucketConsistencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the bucket name as the identifier
+	bucketName := req.ID
+
+	consistency, err := r.client.GetS3BucketConsistency(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Bucket Consistency for %s", bucketName),
+			fmt.Sprintf("Bucket does not exist or its consistency level is not accessible: %s", err.Error()),
+		)
+		return
+	}
+
+	state := S3BucketConsistencyResourceModel{
+		BucketName:  types.StringValue(bucketName),
+		Consistency: types.StringValue(consistency.Consistency),
+		ID:          types.StringValue(bucketName),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Set the ID attribute explicitly for import
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
+}
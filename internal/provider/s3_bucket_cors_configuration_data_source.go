@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &S3BucketCORSConfigurationDataSource{}
+	_ datasource.DataSourceWithConfigure = &S3BucketCORSConfigurationDataSource{}
+)
+
+func NewS3BucketCORSConfigurationDataSource() datasource.DataSource {
+	return &S3BucketCORSConfigurationDataSource{}
+}
+
+// S3BucketCORSConfigurationDataSource defines the data source implementation.
+type S3BucketCORSConfigurationDataSource struct {
+	client *utils.Client
+}
+
+// S3BucketCORSConfigurationDataSourceModel describes the data source data model.
+type S3BucketCORSConfigurationDataSourceModel struct {
+	BucketName types.String    `tfsdk:"bucket_name"`
+	Rules      []CORSRuleModel `tfsdk:"cors_rule"`
+}
+
+func (d *S3BucketCORSConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_cors_configuration"
+}
+
+func (d *S3BucketCORSConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the cross-origin resource sharing (CORS) configuration of a StorageGrid S3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to fetch the CORS configuration for.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cors_rule": schema.ListNestedBlock{
+				Description: "CORS rules of the bucket. Empty when the bucket has no CORS configuration.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier for the rule.",
+							Computed:    true,
+						},
+						"allowed_origins": schema.ListAttribute{
+							Description: "Origins allowed to make cross-origin requests.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"allowed_methods": schema.ListAttribute{
+							Description: "HTTP methods allowed for cross-origin requests.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"allowed_headers": schema.ListAttribute{
+							Description: "Headers allowed in preflight requests.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"expose_headers": schema.ListAttribute{
+							Description: "Response headers that browsers can expose to the requesting application.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Description: "Time in seconds that browsers can cache the response of a preflight request.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *S3BucketCORSConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *S3BucketCORSConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state S3BucketCORSConfigurationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	corsRules, err := d.client.GetS3BucketCORS(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket CORS Configuration for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Rules = mapCORSRules(corsRules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
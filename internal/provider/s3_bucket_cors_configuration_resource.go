@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3BucketCORSConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &S3BucketCORSConfigurationResource{}
+	_ resource.ResourceWithImportState = &S3BucketCORSConfigurationResource{}
+)
+
+// corsAllowedMethods are the HTTP methods a CORS rule can allow.
+var corsAllowedMethods = []string{"GET", "PUT", "POST", "DELETE", "HEAD"}
+
+func NewS3BucketCORSConfigurationResource() resource.Resource {
+	return &S3BucketCORSConfigurationResource{}
+}
+
+// S3BucketCORSConfigurationResource defines the resource implementation.
+type S3BucketCORSConfigurationResource struct {
+	client *utils.Client
+}
+
+// S3BucketCORSConfigurationResourceModel describes the resource data model.
+type S3BucketCORSConfigurationResourceModel struct {
+	BucketName types.String    `tfsdk:"bucket_name"`
+	Rules      []CORSRuleModel `tfsdk:"cors_rule"`
+	ID         types.String    `tfsdk:"id"`
+}
+
+// CORSRuleModel represents a CORS rule. It is shared with the data source, whose
+// rule attributes are the same but computed.
+type CORSRuleModel struct {
+	ID             types.String   `tfsdk:"id"`
+	AllowedOrigins []types.String `tfsdk:"allowed_origins"`
+	AllowedMethods []types.String `tfsdk:"allowed_methods"`
+	AllowedHeaders []types.String `tfsdk:"allowed_headers"`
+	ExposeHeaders  []types.String `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64    `tfsdk:"max_age_seconds"`
+}
+
+func (r *S3BucketCORSConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_cors_configuration"
+}
+
+func (r *S3BucketCORSConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the cross-origin resource sharing (CORS) configuration of a StorageGrid S3 bucket, which lets web applications on other domains access the bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to configure CORS for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the CORS configuration (same as bucket_name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cors_rule": schema.ListNestedBlock{
+				Description: "CORS rules for the bucket. Declaring no rules removes the CORS configuration from the bucket while keeping the resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier for the rule.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"allowed_origins": schema.ListAttribute{
+							Description: "Origins allowed to make cross-origin requests, such as `https://www.example.com`. An origin can contain one `*` wildcard.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"allowed_methods": schema.ListAttribute{
+							Description: "HTTP methods allowed for cross-origin requests. Valid values: GET, PUT, POST, DELETE, HEAD.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(corsAllowedMethods...)),
+							},
+						},
+						"allowed_headers": schema.ListAttribute{
+							Description: "Headers allowed in preflight requests through the Access-Control-Request-Headers header. A header can contain one `*` wildcard.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.ListAttribute{
+							Description: "Response headers that browsers can expose to the requesting application, such as `ETag`.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Description: "Time in seconds that browsers can cache the response of a preflight request.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, math.MaxInt32),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *S3BucketCORSConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// buildCORSRules converts the Terraform rule models into the API model.
+func buildCORSRules(rules []CORSRuleModel) []utils.CORSRule {
+	corsRules := make([]utils.CORSRule, len(rules))
+	for i, rule := range rules {
+		corsRules[i] = utils.CORSRule{
+			ID:             rule.ID.ValueString(),
+			AllowedOrigins: stringValues(rule.AllowedOrigins),
+			AllowedMethods: stringValues(rule.AllowedMethods),
+			AllowedHeaders: stringValues(rule.AllowedHeaders),
+			ExposeHeaders:  stringValues(rule.ExposeHeaders),
+			MaxAgeSeconds:  int(rule.MaxAgeSeconds.ValueInt64()),
+		}
+	}
+
+	return corsRules
+}
+
+// mapCORSRules converts the API model into the Terraform rule models. Unset values
+// are null, as in a configuration that omits them.
+func mapCORSRules(corsRules []utils.CORSRule) []CORSRuleModel {
+	var rules []CORSRuleModel
+	for _, rule := range corsRules {
+		ruleModel := CORSRuleModel{
+			ID:             types.StringNull(),
+			AllowedOrigins: stringModels(rule.AllowedOrigins),
+			AllowedMethods: stringModels(rule.AllowedMethods),
+			AllowedHeaders: stringModels(rule.AllowedHeaders),
+			ExposeHeaders:  stringModels(rule.ExposeHeaders),
+			MaxAgeSeconds:  types.Int64Null(),
+		}
+		if rule.ID != "" {
+			ruleModel.ID = types.StringValue(rule.ID)
+		}
+		if rule.MaxAgeSeconds > 0 {
+			ruleModel.MaxAgeSeconds = types.Int64Value(int64(rule.MaxAgeSeconds))
+		}
+
+		rules = append(rules, ruleModel)
+	}
+
+	return rules
+}
+
+// stringValues returns the values of a list of strings, nil for an empty list.
+func stringValues(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = value.ValueString()
+	}
+	return result
+}
+
+// stringModels returns the Terraform values of a list of strings, nil (a null list)
+// for an empty list.
+func stringModels(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]types.String, len(values))
+	for i, value := range values {
+		result[i] = types.StringValue(value)
+	}
+	return result
+}
+
+// applyCORSRules writes the rules to the bucket. An empty rule list removes the CORS
+// configuration from the bucket, since the S3 API rejects a configuration without rules.
+func (r *S3BucketCORSConfigurationResource) applyCORSRules(ctx context.Context, bucketName string, rules []CORSRuleModel) error {
+	if len(rules) == 0 {
+		return r.client.DeleteS3BucketCORS(ctx, bucketName)
+	}
+
+	return r.client.PutS3BucketCORS(ctx, bucketName, buildCORSRules(rules))
+}
+
+func (r *S3BucketCORSConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.applyCORSRules(ctx, bucketName, plan.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Bucket CORS Configuration for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set the ID (same as bucket name)
+	plan.ID = types.StringValue(bucketName)
+
+	// Save the plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketCORSConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3BucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	corsRules, err := r.client.GetS3BucketCORS(ctx, bucketName)
+	if err != nil {
+		// The bucket was deleted outside of Terraform
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket CORS Configuration for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Convert API model to Terraform model
+	state.Rules = mapCORSRules(corsRules)
+	state.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3BucketCORSConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.applyCORSRules(ctx, bucketName, plan.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Bucket CORS Configuration for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the updated plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketCORSConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3BucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	err := r.client.DeleteS3BucketCORS(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete S3 Bucket CORS Configuration for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// State is automatically cleared on successful delete
+}
+
+func (r *S3BucketCORSConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the bucket name as the identifier
+	bucketName := req.ID
+
+	corsRules, err := r.client.GetS3BucketCORS(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Bucket CORS Configuration for %s", bucketName),
+			fmt.Sprintf("Bucket does not exist or CORS configuration is not accessible: %s", err.Error()),
+		)
+		return
+	}
+
+	state := S3BucketCORSConfigurationResourceModel{
+		BucketName: types.StringValue(bucketName),
+		Rules:      mapCORSRules(corsRules),
+		ID:         types.StringValue(bucketName),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Set the ID attribute explicitly for import
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
+}
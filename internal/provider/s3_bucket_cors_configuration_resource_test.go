@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestBuildCORSRules(t *testing.T) {
+	rules := []CORSRuleModel{
+		{
+			ID:             types.StringValue("web"),
+			AllowedOrigins: []types.String{types.StringValue("https://example.com")},
+			AllowedMethods: []types.String{types.StringValue("GET"), types.StringValue("HEAD")},
+			AllowedHeaders: []types.String{types.StringValue("*")},
+			ExposeHeaders:  []types.String{types.StringValue("ETag")},
+			MaxAgeSeconds:  types.Int64Value(3000),
+		},
+		{
+			ID:             types.StringNull(),
+			AllowedOrigins: []types.String{types.StringValue("*")},
+			AllowedMethods: []types.String{types.StringValue("PUT")},
+			MaxAgeSeconds:  types.Int64Null(),
+		},
+	}
+
+	want := []utils.CORSRule{
+		{
+			ID:             "web",
+			AllowedOrigins: []string{"https://example.com"},
+			AllowedMethods: []string{"GET", "HEAD"},
+			AllowedHeaders: []string{"*"},
+			ExposeHeaders:  []string{"ETag"},
+			MaxAgeSeconds:  3000,
+		},
+		{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{"PUT"},
+		},
+	}
+
+	if got := buildCORSRules(rules); !reflect.DeepEqual(got, want) {
+		t.Fatalf("buildCORSRules() = %+v, want %+v", got, want)
+	}
+
+	// Optional values omitted from the configuration read back as null, without drift
+	if got := mapCORSRules(buildCORSRules(rules)); !reflect.DeepEqual(got, rules) {
+		t.Fatalf("mapCORSRules() = %+v, want %+v", got, rules)
+	}
+}
+
+func TestMapCORSRulesEmpty(t *testing.T) {
+	if got := mapCORSRules([]utils.CORSRule{}); got != nil {
+		t.Fatalf("mapCORSRules() = %+v, want no rules", got)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3BucketCrossGridReplicationResource{}
+	_ resource.ResourceWithConfigure   = &S3BucketCrossGridReplicationResource{}
+	_ resource.ResourceWithImportState = &S3BucketCrossGridReplicationResource{}
+)
+
+func NewS3BucketCrossGridReplicationResource() resource.Resource {
+	return &S3BucketCrossGridReplicationResource{}
+}
+
+// S3BucketCrossGridReplicationResource defines the resource implementation.
+type S3BucketCrossGridReplicationResource struct {
+	client *utils.Client
+}
+
+// S3BucketCrossGridReplicationResourceModel describes the resource data model.
+type S3BucketCrossGridReplicationResourceModel struct {
+	BucketName types.String                    `tfsdk:"bucket_name"`
+	Rules      []CrossGridReplicationRuleModel `tfsdk:"rule"`
+	ID         types.String                    `tfsdk:"id"`
+}
+
+// CrossGridReplicationRuleModel represents a cross-grid replication rule.
+type CrossGridReplicationRuleModel struct {
+	GridConnectionID types.String `tfsdk:"grid_connection_id"`
+}
+
+func (r *S3BucketCrossGridReplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_cross_grid_replication"
+}
+
+func (r *S3BucketCrossGridReplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the cross-grid replication of a StorageGrid S3 bucket, which replicates its objects to the bucket of the same name on another grid of a grid federation. " +
+			"The tenant account must have permission to use the grid federation connection, versioning must be enabled and the destination bucket must exist.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to replicate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the cross-grid replication (same as bucket_name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "Cross-grid replication rules for the bucket. Declaring no rules stops the replication of the bucket while keeping the resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"grid_connection_id": schema.StringAttribute{
+							Description: "The ID of the grid federation connection to the grid to replicate the objects to.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *S3BucketCrossGridReplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// buildCrossGridReplicationRules converts the Terraform rule models into the API model.
+func buildCrossGridReplicationRules(rules []CrossGridReplicationRuleModel) []utils.CrossGridReplicationRule {
+	apiRules := make([]utils.CrossGridReplicationRule, len(rules))
+	for i, rule := range rules {
+		apiRules[i] = utils.CrossGridReplicationRule{Grid: rule.GridConnectionID.ValueString()}
+	}
+	return apiRules
+}
+
+// mapCrossGridReplicationRules converts the API model into the Terraform rule models.
+func mapCrossGridReplicationRules(config *utils.CrossGridReplicationConfig) []CrossGridReplicationRuleModel {
+	var rules []CrossGridReplicationRuleModel
+	for _, rule := range config.Rules {
+		rules = append(rules, CrossGridReplicationRuleModel{GridConnectionID: types.StringValue(rule.Grid)})
+	}
+	return rules
+}
+
+func (r *S3BucketCrossGridReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketCrossGridReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.UpdateS3BucketCrossGridReplication(ctx, bucketName, buildCrossGridReplicationRules(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Bucket Cross-Grid Replication for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set the ID (same as bucket name)
+	plan.ID = types.StringValue(bucketName)
+
+	// Save the plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketCrossGridReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3BucketCrossGridReplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	config, err := r.client.GetS3BucketCrossGridReplication(ctx, bucketName)
+	if err != nil {
+		// The bucket was deleted outside of Terraform
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket Cross-Grid Replication for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Convert API model to Terraform model
+	state.Rules = mapCrossGridReplicationRules(config)
+	state.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3BucketCrossGridReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketCrossGridReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.UpdateS3BucketCrossGridReplication(ctx, bucketName, buildCrossGridReplicationRules(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Bucket Cross-Grid Replication for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the updated plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketCrossGridReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3BucketCrossGridReplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	// Removing all rules stops the replication. A bucket that no longer exists counts as done.
+	err := r.client.UpdateS3BucketCrossGridReplication(ctx, bucketName, nil)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete S3 Bucket Cross-Grid Replication for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// State is automatically cleared on successful delete
+}
+
+func (r *S3BucketCrossGridReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the bucket name as the identifier
+	bucketName := req.ID
+
+	config, err := r.client.GetS3BucketCrossGridReplication(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Bucket Cross-Grid Replication for %s", bucketName),
+			fmt.Sprintf("Bucket does not exist or cross-grid replication is not accessible: %s", err.Error()),
+		)
+		return
+	}
+
+	state := S3BucketCrossGridReplicationResourceModel{
+		BucketName: types.StringValue(bucketName),
+		Rules:      mapCrossGridReplicationRules(config),
+		ID:         types.StringValue(bucketName),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Set the ID attribute explicitly for import
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
+}
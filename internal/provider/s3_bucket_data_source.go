@@ -105,7 +105,7 @@ func (d *S3BucketDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				},
 			},
 			"delete_status": schema.SingleNestedAttribute{
-				Description: "Delete object status for the bucket.",
+				Description: "Delete object status for the bucket. Only set when the provider includes \"deleteObjectStatus\" in bucket_list_include.",
 				Computed:    true,
 				Attributes: map[string]schema.Attribute{
 					"is_deleting_objects": schema.BoolAttribute{
@@ -130,15 +130,15 @@ func (d *S3BucketDataSource) Configure(_ context.Context, req datasource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *S3BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -150,7 +150,7 @@ func (d *S3BucketDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	bucketName := state.BucketName.ValueString()
-	bucket, err := d.client.GetS3Bucket(bucketName)
+	bucket, err := d.client.GetS3Bucket(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket %s", bucketName),
@@ -166,7 +166,7 @@ func (d *S3BucketDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if bucket.Region != "" {
 		state.Region = types.StringValue(bucket.Region)
 	} else {
-		state.Region = types.StringValue("us-east-1")
+		state.Region = types.StringValue(defaultBucketRegion)
 	}
 
 	// Handle optional S3 object lock configuration
@@ -36,16 +36,28 @@ type S3BucketLifecycleConfigurationDataSourceModel struct {
 
 // LifecycleRuleDataSourceModel represents a lifecycle rule.
 type LifecycleRuleDataSourceModel struct {
-	ID                          types.String                               `tfsdk:"id"`
-	Status                      types.String                               `tfsdk:"status"`
-	Filter                      *LifecycleFilterDataSourceModel            `tfsdk:"filter"`
-	Expiration                  *LifecycleExpirationDataSourceModel        `tfsdk:"expiration"`
-	NoncurrentVersionExpiration *LifecycleNoncurrentVersionDataSourceModel `tfsdk:"noncurrent_version_expiration"`
+	ID                             types.String                                  `tfsdk:"id"`
+	Status                         types.String                                  `tfsdk:"status"`
+	Filter                         *LifecycleFilterDataSourceModel               `tfsdk:"filter"`
+	Expiration                     *LifecycleExpirationDataSourceModel           `tfsdk:"expiration"`
+	NoncurrentVersionExpiration    *LifecycleNoncurrentVersionDataSourceModel    `tfsdk:"noncurrent_version_expiration"`
+	AbortIncompleteMultipartUpload *LifecycleAbortMultipartUploadDataSourceModel `tfsdk:"abort_incomplete_multipart_upload"`
 }
 
 // LifecycleFilterDataSourceModel represents a lifecycle rule filter.
 type LifecycleFilterDataSourceModel struct {
-	Prefix types.String `tfsdk:"prefix"`
+	Prefix                types.String                       `tfsdk:"prefix"`
+	ObjectSizeGreaterThan types.Int64                        `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64                        `tfsdk:"object_size_less_than"`
+	And                   *LifecycleFilterAndDataSourceModel `tfsdk:"and"`
+}
+
+// LifecycleFilterAndDataSourceModel represents a lifecycle rule filter combining several predicates.
+type LifecycleFilterAndDataSourceModel struct {
+	Prefix                types.String            `tfsdk:"prefix"`
+	Tags                  map[string]types.String `tfsdk:"tags"`
+	ObjectSizeGreaterThan types.Int64             `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64             `tfsdk:"object_size_less_than"`
 }
 
 // LifecycleExpirationDataSourceModel represents expiration settings.
@@ -57,7 +69,13 @@ type LifecycleExpirationDataSourceModel struct {
 
 // LifecycleNoncurrentVersionDataSourceModel represents noncurrent version expiration settings.
 type LifecycleNoncurrentVersionDataSourceModel struct {
-	NoncurrentDays types.Int64 `tfsdk:"noncurrent_days"`
+	NoncurrentDays          types.Int64 `tfsdk:"noncurrent_days"`
+	NewerNoncurrentVersions types.Int64 `tfsdk:"newer_noncurrent_versions"`
+}
+
+// LifecycleAbortMultipartUploadDataSourceModel represents the settings for aborting incomplete multipart uploads.
+type LifecycleAbortMultipartUploadDataSourceModel struct {
+	DaysAfterInitiation types.Int64 `tfsdk:"days_after_initiation"`
 }
 
 func (d *S3BucketLifecycleConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -96,6 +114,44 @@ func (d *S3BucketLifecycleConfigurationDataSource) Schema(ctx context.Context, r
 									Computed:    true,
 									Optional:    true,
 								},
+								"object_size_greater_than": schema.Int64Attribute{
+									Description: "Minimum object size in bytes, exclusive, for the rule to apply.",
+									Computed:    true,
+									Optional:    true,
+								},
+								"object_size_less_than": schema.Int64Attribute{
+									Description: "Maximum object size in bytes, exclusive, for the rule to apply.",
+									Computed:    true,
+									Optional:    true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"and": schema.SingleNestedBlock{
+									Description: "Prefix, object tags and object size bounds that objects must all match for the rule to apply.",
+									Attributes: map[string]schema.Attribute{
+										"prefix": schema.StringAttribute{
+											Description: "Object key prefix that identifies the objects to which the rule applies.",
+											Computed:    true,
+											Optional:    true,
+										},
+										"tags": schema.MapAttribute{
+											Description: "Object tags, all of which an object must have for the rule to apply.",
+											ElementType: types.StringType,
+											Computed:    true,
+											Optional:    true,
+										},
+										"object_size_greater_than": schema.Int64Attribute{
+											Description: "Minimum object size in bytes, exclusive, for the rule to apply.",
+											Computed:    true,
+											Optional:    true,
+										},
+										"object_size_less_than": schema.Int64Attribute{
+											Description: "Maximum object size in bytes, exclusive, for the rule to apply.",
+											Computed:    true,
+											Optional:    true,
+										},
+									},
+								},
 							},
 						},
 						"expiration": schema.SingleNestedBlock{
@@ -126,6 +182,21 @@ func (d *S3BucketLifecycleConfigurationDataSource) Schema(ctx context.Context, r
 									Computed:    true,
 									Optional:    true,
 								},
+								"newer_noncurrent_versions": schema.Int64Attribute{
+									Description: "Number of most recent noncurrent versions that are kept.",
+									Computed:    true,
+									Optional:    true,
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": schema.SingleNestedBlock{
+							Description: "Settings for aborting multipart uploads that are not completed.",
+							Attributes: map[string]schema.Attribute{
+								"days_after_initiation": schema.Int64Attribute{
+									Description: "Number of days after a multipart upload is initiated when StorageGrid aborts it if it is not completed.",
+									Computed:    true,
+									Optional:    true,
+								},
 							},
 						},
 					},
@@ -140,16 +211,16 @@ func (d *S3BucketLifecycleConfigurationDataSource) Configure(ctx context.Context
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *S3BucketLifecycleConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -161,7 +232,7 @@ func (d *S3BucketLifecycleConfigurationDataSource) Read(ctx context.Context, req
 	}
 
 	bucketName := state.BucketName.ValueString()
-	lifecycleConfig, err := d.client.GetS3BucketLifecycleConfiguration(bucketName)
+	lifecycleConfig, err := d.client.GetS3BucketLifecycleConfiguration(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket Lifecycle Configuration for %s", bucketName),
@@ -181,7 +252,20 @@ func (d *S3BucketLifecycleConfigurationDataSource) Read(ctx context.Context, req
 		// Handle filter
 		if rule.Filter != nil {
 			ruleModel.Filter = &LifecycleFilterDataSourceModel{
-				Prefix: types.StringValue(rule.Filter.Prefix),
+				Prefix:                types.StringNull(),
+				ObjectSizeGreaterThan: types.Int64Null(),
+				ObjectSizeLessThan:    types.Int64Null(),
+			}
+			switch {
+			case rule.Filter.And != nil:
+				andModel := LifecycleFilterAndDataSourceModel(*mapLifecycleFilterAnd(rule.Filter.And))
+				ruleModel.Filter.And = &andModel
+			case rule.Filter.ObjectSizeGreaterThan > 0:
+				ruleModel.Filter.ObjectSizeGreaterThan = types.Int64Value(rule.Filter.ObjectSizeGreaterThan)
+			case rule.Filter.ObjectSizeLessThan > 0:
+				ruleModel.Filter.ObjectSizeLessThan = types.Int64Value(rule.Filter.ObjectSizeLessThan)
+			default:
+				ruleModel.Filter.Prefix = types.StringValue(rule.Filter.Prefix)
 			}
 		}
 
@@ -208,7 +292,18 @@ func (d *S3BucketLifecycleConfigurationDataSource) Read(ctx context.Context, req
 		// Handle noncurrent version expiration
 		if rule.NoncurrentVersionExpiration != nil {
 			ruleModel.NoncurrentVersionExpiration = &LifecycleNoncurrentVersionDataSourceModel{
-				NoncurrentDays: types.Int64Value(int64(rule.NoncurrentVersionExpiration.NoncurrentDays)),
+				NoncurrentDays:          types.Int64Value(int64(rule.NoncurrentVersionExpiration.NoncurrentDays)),
+				NewerNoncurrentVersions: types.Int64Null(),
+			}
+			if rule.NoncurrentVersionExpiration.NewerNoncurrentVersions > 0 {
+				ruleModel.NoncurrentVersionExpiration.NewerNoncurrentVersions = types.Int64Value(int64(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions))
+			}
+		}
+
+		// Handle incomplete multipart upload abortion
+		if rule.AbortIncompleteMultipartUpload != nil {
+			ruleModel.AbortIncompleteMultipartUpload = &LifecycleAbortMultipartUploadDataSourceModel{
+				DaysAfterInitiation: types.Int64Value(int64(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
 			}
 		}
 
@@ -6,8 +6,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,11 +29,16 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &S3BucketLifecycleConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &S3BucketLifecycleConfigurationResource{}
-	_ resource.ResourceWithImportState = &S3BucketLifecycleConfigurationResource{}
+	_ resource.Resource                   = &S3BucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &S3BucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithImportState    = &S3BucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &S3BucketLifecycleConfigurationResource{}
 )
 
+// lifecycleDateLayout is the format of expiration dates, which StorageGrid requires to
+// be at midnight UTC.
+const lifecycleDateLayout = "2006-01-02T15:04:05.000Z"
+
 func NewS3BucketLifecycleConfigurationResource() resource.Resource {
 	return &S3BucketLifecycleConfigurationResource{}
 }
@@ -39,20 +53,33 @@ type S3BucketLifecycleConfigurationResourceModel struct {
 	BucketName types.String                 `tfsdk:"bucket_name"`
 	Rules      []LifecycleRuleResourceModel `tfsdk:"rule"`
 	ID         types.String                 `tfsdk:"id"`
+	Timeouts   timeouts.Value               `tfsdk:"timeouts"`
 }
 
 // LifecycleRuleResourceModel represents a lifecycle rule.
 type LifecycleRuleResourceModel struct {
-	ID                          types.String                             `tfsdk:"id"`
-	Status                      types.String                             `tfsdk:"status"`
-	Filter                      *LifecycleFilterResourceModel            `tfsdk:"filter"`
-	Expiration                  *LifecycleExpirationResourceModel        `tfsdk:"expiration"`
-	NoncurrentVersionExpiration *LifecycleNoncurrentVersionResourceModel `tfsdk:"noncurrent_version_expiration"`
+	ID                             types.String                                `tfsdk:"id"`
+	Status                         types.String                                `tfsdk:"status"`
+	Filter                         *LifecycleFilterResourceModel               `tfsdk:"filter"`
+	Expiration                     *LifecycleExpirationResourceModel           `tfsdk:"expiration"`
+	NoncurrentVersionExpiration    *LifecycleNoncurrentVersionResourceModel    `tfsdk:"noncurrent_version_expiration"`
+	AbortIncompleteMultipartUpload *LifecycleAbortMultipartUploadResourceModel `tfsdk:"abort_incomplete_multipart_upload"`
 }
 
 // LifecycleFilterResourceModel represents a lifecycle rule filter.
 type LifecycleFilterResourceModel struct {
-	Prefix types.String `tfsdk:"prefix"`
+	Prefix                types.String                     `tfsdk:"prefix"`
+	ObjectSizeGreaterThan types.Int64                      `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64                      `tfsdk:"object_size_less_than"`
+	And                   *LifecycleFilterAndResourceModel `tfsdk:"and"`
+}
+
+// LifecycleFilterAndResourceModel represents a lifecycle rule filter combining several predicates.
+type LifecycleFilterAndResourceModel struct {
+	Prefix                types.String            `tfsdk:"prefix"`
+	Tags                  map[string]types.String `tfsdk:"tags"`
+	ObjectSizeGreaterThan types.Int64             `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64             `tfsdk:"object_size_less_than"`
 }
 
 // LifecycleExpirationResourceModel represents expiration settings.
@@ -64,11 +91,17 @@ type LifecycleExpirationResourceModel struct {
 
 // LifecycleNoncurrentVersionResourceModel represents noncurrent version expiration settings.
 type LifecycleNoncurrentVersionResourceModel struct {
-	NoncurrentDays types.Int64 `tfsdk:"noncurrent_days"`
+	NoncurrentDays          types.Int64 `tfsdk:"noncurrent_days"`
+	NewerNoncurrentVersions types.Int64 `tfsdk:"newer_noncurrent_versions"`
+}
+
+// LifecycleAbortMultipartUploadResourceModel represents the settings for aborting incomplete multipart uploads.
+type LifecycleAbortMultipartUploadResourceModel struct {
+	DaysAfterInitiation types.Int64 `tfsdk:"days_after_initiation"`
 }
 
 // nonEmptyFilterValidator ensures that a declared filter block specifies a
-// non-empty prefix. StorageGrid always stores (and returns) a <Filter> element,
+// non-empty prefix, an object size bound or an and block. StorageGrid always stores (and returns) a <Filter> element,
 // so an empty prefix is indistinguishable from no filter on read — both mean
 // "apply to all objects". Allowing an empty filter block would canonicalize to
 // no filter in state and produce a perpetual diff, so we require it to be
@@ -76,7 +109,7 @@ type LifecycleNoncurrentVersionResourceModel struct {
 type nonEmptyFilterValidator struct{}
 
 func (v nonEmptyFilterValidator) Description(ctx context.Context) string {
-	return "filter block must specify a non-empty prefix; omit the filter block to apply the rule to all objects"
+	return "filter block must specify a non-empty prefix, an object size bound or an and block; omit the filter block to apply the rule to all objects"
 }
 
 func (v nonEmptyFilterValidator) MarkdownDescription(ctx context.Context) string {
@@ -89,6 +122,16 @@ func (v nonEmptyFilterValidator) ValidateObject(ctx context.Context, req validat
 		return
 	}
 
+	// An and block is validated by filterAndValidator
+	if and, ok := req.ConfigValue.Attributes()["and"].(types.Object); ok && !and.IsNull() {
+		return
+	}
+	for _, name := range []string{"object_size_greater_than", "object_size_less_than"} {
+		if size, ok := req.ConfigValue.Attributes()[name].(types.Int64); ok && !size.IsNull() {
+			return
+		}
+	}
+
 	prefix, ok := req.ConfigValue.Attributes()["prefix"].(types.String)
 	if !ok || prefix.IsUnknown() {
 		return
@@ -98,7 +141,55 @@ func (v nonEmptyFilterValidator) ValidateObject(ctx context.Context, req validat
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Filter Configuration",
-			"The filter block must specify a non-empty prefix. To apply the rule to all objects, omit the filter block entirely.",
+			"The filter block must specify a non-empty prefix, an object size bound or an and block. To apply the rule to all objects, omit the filter block entirely.",
+		)
+	}
+}
+
+// filterAndValidator ensures that a declared and block specifies at least one
+// predicate, and that its object size bounds leave a range of sizes to match.
+type filterAndValidator struct{}
+
+func (v filterAndValidator) Description(ctx context.Context) string {
+	return "and block must specify at least one of prefix, tags, object_size_greater_than and object_size_less_than, with object_size_less_than above object_size_greater_than"
+}
+
+func (v filterAndValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterAndValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	predicates := 0
+	for _, value := range attrs {
+		if value.IsUnknown() {
+			return
+		}
+		if !value.IsNull() {
+			predicates++
+		}
+	}
+	if predicates == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Filter Configuration",
+			"The and block must specify at least one of prefix, tags, object_size_greater_than and object_size_less_than.",
+		)
+		return
+	}
+
+	greaterThan, _ := attrs["object_size_greater_than"].(types.Int64)
+	lessThan, _ := attrs["object_size_less_than"].(types.Int64)
+	if !greaterThan.IsNull() && !lessThan.IsNull() && lessThan.ValueInt64() <= greaterThan.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("object_size_less_than"),
+			"Invalid Filter Configuration",
+			fmt.Sprintf("object_size_less_than (%d) must be greater than object_size_greater_than (%d), otherwise the rule matches no objects.",
+				lessThan.ValueInt64(), greaterThan.ValueInt64()),
 		)
 	}
 }
@@ -127,8 +218,10 @@ func (r *S3BucketLifecycleConfigurationResource) Schema(ctx context.Context, req
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 			"rule": schema.ListNestedBlock{
-				Description: "Lifecycle rules for the bucket.",
+				Description: "Lifecycle rules for the bucket. Declaring no rules removes the lifecycle configuration from the bucket while keeping the resource, which disables all rules. " +
+					"Rules read from the bucket are matched to the configured rules by id, so the order StorageGrid returns them in does not show as a change.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -142,6 +235,9 @@ func (r *S3BucketLifecycleConfigurationResource) Schema(ctx context.Context, req
 						"status": schema.StringAttribute{
 							Description: "Status of the rule (Enabled or Disabled).",
 							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Enabled", "Disabled"),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -149,8 +245,76 @@ func (r *S3BucketLifecycleConfigurationResource) Schema(ctx context.Context, req
 							Description: "Filter for the lifecycle rule. Omit this block to apply the rule to all objects.",
 							Attributes: map[string]schema.Attribute{
 								"prefix": schema.StringAttribute{
-									Description: "Object key prefix that identifies the objects to which the rule applies.",
+									Description: "Object key prefix that identifies the objects to which the rule applies. Conflicts with object_size_greater_than, object_size_less_than and and.",
 									Optional:    true,
+									Validators: []validator.String{
+										stringvalidator.ConflictsWith(
+											path.MatchRelative().AtParent().AtName("object_size_greater_than"),
+											path.MatchRelative().AtParent().AtName("object_size_less_than"),
+											path.MatchRelative().AtParent().AtName("and"),
+										),
+									},
+								},
+								"object_size_greater_than": schema.Int64Attribute{
+									Description: "Minimum object size in bytes, exclusive, for the rule to apply. Conflicts with prefix, object_size_less_than and and; use an and block to combine predicates.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.ConflictsWith(
+											path.MatchRelative().AtParent().AtName("object_size_less_than"),
+											path.MatchRelative().AtParent().AtName("and"),
+										),
+									},
+								},
+								"object_size_less_than": schema.Int64Attribute{
+									Description: "Maximum object size in bytes, exclusive, for the rule to apply. Conflicts with prefix, object_size_greater_than and and; use an and block to combine predicates.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.ConflictsWith(
+											path.MatchRelative().AtParent().AtName("and"),
+										),
+									},
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"and": schema.SingleNestedBlock{
+									Description: "Combines a prefix, object tags and object size bounds; the rule applies to the objects that match all of them. Conflicts with prefix.",
+									Attributes: map[string]schema.Attribute{
+										"prefix": schema.StringAttribute{
+											Description: "Object key prefix that identifies the objects to which the rule applies.",
+											Optional:    true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtLeast(1),
+											},
+										},
+										"tags": schema.MapAttribute{
+											Description: "Object tags, all of which an object must have for the rule to apply.",
+											ElementType: types.StringType,
+											Optional:    true,
+											Validators: []validator.Map{
+												mapvalidator.SizeAtLeast(1),
+												mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 128)),
+											},
+										},
+										"object_size_greater_than": schema.Int64Attribute{
+											Description: "Minimum object size in bytes, exclusive, for the rule to apply.",
+											Optional:    true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+											},
+										},
+										"object_size_less_than": schema.Int64Attribute{
+											Description: "Maximum object size in bytes, exclusive, for the rule to apply.",
+											Optional:    true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+											},
+										},
+									},
+									Validators: []validator.Object{
+										filterAndValidator{},
+									},
 								},
 							},
 							Validators: []validator.Object{
@@ -161,11 +325,15 @@ func (r *S3BucketLifecycleConfigurationResource) Schema(ctx context.Context, req
 							Description: "Expiration settings for current object versions.",
 							Attributes: map[string]schema.Attribute{
 								"days": schema.Int64Attribute{
-									Description: "Number of days after object creation when the object expires.",
+									Description: "Number of days after object creation when the object expires. Conflicts with date.",
 									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("date")),
+									},
 								},
 								"date": schema.StringAttribute{
-									Description: "Date when objects expire (ISO 8601 format).",
+									Description: "Date when objects expire (ISO 8601 format at midnight UTC, such as 2026-12-31T00:00:00.000Z). Conflicts with days.",
 									Optional:    true,
 								},
 								"expired_object_delete_marker": schema.BoolAttribute{
@@ -186,9 +354,35 @@ func (r *S3BucketLifecycleConfigurationResource) Schema(ctx context.Context, req
 								"noncurrent_days": schema.Int64Attribute{
 									Description: "Number of days after an object becomes noncurrent when it expires.",
 									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"newer_noncurrent_versions": schema.Int64Attribute{
+									Description: "Number of most recent noncurrent versions to keep. Older noncurrent versions expire noncurrent_days after they become noncurrent. Requires noncurrent_days.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("noncurrent_days")),
+									},
 								},
 							},
 						},
+						"abort_incomplete_multipart_upload": schema.SingleNestedBlock{
+							Description: "Settings for aborting multipart uploads that are not completed, which frees the capacity of their uploaded parts.",
+							Attributes: map[string]schema.Attribute{
+								"days_after_initiation": schema.Int64Attribute{
+									Description: "Number of days after a multipart upload is initiated when StorageGrid aborts it if it is not completed.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+							Validators: []validator.Object{
+								objectvalidator.AlsoRequires(path.MatchRelative().AtName("days_after_initiation")),
+							},
+						},
 					},
 				},
 			},
@@ -201,16 +395,94 @@ func (r *S3BucketLifecycleConfigurationResource) Configure(ctx context.Context,
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+}
+
+// ValidateConfig checks the constraints of lifecycle rules that span several attributes:
+// rule IDs are unique, every rule has an action, an expiration sets one of days, date
+// and expired_object_delete_marker, and dates are at midnight UTC.
+func (r *S3BucketLifecycleConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule"), &rules)...)
+	if resp.Diagnostics.HasError() || rules.IsNull() || rules.IsUnknown() {
+		return
+	}
+
+	// Rules with unknown blocks, such as from dynamic blocks, are validated once known
+	var models []LifecycleRuleResourceModel
+	if diags := rules.ElementsAs(ctx, &models, false); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateLifecycleRules(models)...)
+}
+
+// validateLifecycleRules returns the errors of the rules for ValidateConfig.
+func validateLifecycleRules(rules []LifecycleRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		rulePath := path.Root("rule").AtListIndex(i)
+
+		if !rule.ID.IsNull() && !rule.ID.IsUnknown() {
+			if first, ok := ids[rule.ID.ValueString()]; ok {
+				diags.AddAttributeError(
+					rulePath.AtName("id"),
+					"Duplicate Lifecycle Rule ID",
+					fmt.Sprintf("The rule ID %q is already used by rule %d. Rule IDs must be unique within a bucket.", rule.ID.ValueString(), first),
+				)
+			} else {
+				ids[rule.ID.ValueString()] = i
+			}
+		}
+
+		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil && rule.AbortIncompleteMultipartUpload == nil {
+			diags.AddAttributeError(
+				rulePath,
+				"Missing Lifecycle Rule Action",
+				"The rule must declare at least one of the expiration, noncurrent_version_expiration and abort_incomplete_multipart_upload blocks.",
+			)
+		}
+
+		if expiration := rule.Expiration; expiration != nil {
+			if expiration.Days.IsNull() && expiration.Date.IsNull() && expiration.ExpiredObjectDeleteMarker.IsNull() {
+				diags.AddAttributeError(
+					rulePath.AtName("expiration"),
+					"Invalid Expiration Configuration",
+					"The expiration block must set one of days, date and expired_object_delete_marker.",
+				)
+			}
+			if !expiration.Date.IsNull() && !expiration.Date.IsUnknown() {
+				date, err := time.Parse(lifecycleDateLayout, expiration.Date.ValueString())
+				if err != nil || !date.Equal(date.Truncate(24*time.Hour)) {
+					diags.AddAttributeError(
+						rulePath.AtName("expiration").AtName("date"),
+						"Invalid Expiration Date",
+						fmt.Sprintf("The expiration date %q must be at midnight UTC in the format 2006-01-02T00:00:00.000Z.", expiration.Date.ValueString()),
+					)
+				}
+			}
+		}
+
+		if noncurrent := rule.NoncurrentVersionExpiration; noncurrent != nil && noncurrent.NoncurrentDays.IsNull() {
+			diags.AddAttributeError(
+				rulePath.AtName("noncurrent_version_expiration").AtName("noncurrent_days"),
+				"Missing Noncurrent Days",
+				"The noncurrent_version_expiration block must set noncurrent_days.",
+			)
+		}
+	}
+
+	return diags
 }
 
 // buildLifecycleConfiguration converts the Terraform rule models into the API model.
@@ -228,7 +500,20 @@ func buildLifecycleConfiguration(rules []LifecycleRuleResourceModel) *utils.Life
 		// Handle filter
 		if rule.Filter != nil {
 			apiRule.Filter = &utils.Filter{
-				Prefix: rule.Filter.Prefix.ValueString(),
+				Prefix:                rule.Filter.Prefix.ValueString(),
+				ObjectSizeGreaterThan: rule.Filter.ObjectSizeGreaterThan.ValueInt64(),
+				ObjectSizeLessThan:    rule.Filter.ObjectSizeLessThan.ValueInt64(),
+			}
+			if and := rule.Filter.And; and != nil {
+				apiRule.Filter.And = &utils.FilterAnd{
+					Prefix:                and.Prefix.ValueString(),
+					ObjectSizeGreaterThan: and.ObjectSizeGreaterThan.ValueInt64(),
+					ObjectSizeLessThan:    and.ObjectSizeLessThan.ValueInt64(),
+				}
+				// Sort the tags so that requests are reproducible
+				for _, key := range slices.Sorted(maps.Keys(and.Tags)) {
+					apiRule.Filter.And.Tags = append(apiRule.Filter.And.Tags, utils.FilterTag{Key: key, Value: and.Tags[key].ValueString()})
+				}
 			}
 		}
 
@@ -251,7 +536,15 @@ func buildLifecycleConfiguration(rules []LifecycleRuleResourceModel) *utils.Life
 		// Handle noncurrent version expiration
 		if rule.NoncurrentVersionExpiration != nil {
 			apiRule.NoncurrentVersionExpiration = &utils.NoncurrentVersionExpiration{
-				NoncurrentDays: int(rule.NoncurrentVersionExpiration.NoncurrentDays.ValueInt64()),
+				NoncurrentDays:          int(rule.NoncurrentVersionExpiration.NoncurrentDays.ValueInt64()),
+				NewerNoncurrentVersions: int(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions.ValueInt64()),
+			}
+		}
+
+		// Handle incomplete multipart upload abortion
+		if rule.AbortIncompleteMultipartUpload != nil {
+			apiRule.AbortIncompleteMultipartUpload = &utils.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: int(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation.ValueInt64()),
 			}
 		}
 
@@ -273,7 +566,19 @@ func mapLifecycleRules(lifecycleConfig *utils.LifecycleConfiguration) []Lifecycl
 		// Handle filter
 		if rule.Filter != nil {
 			ruleModel.Filter = &LifecycleFilterResourceModel{
-				Prefix: types.StringValue(rule.Filter.Prefix),
+				Prefix:                types.StringNull(),
+				ObjectSizeGreaterThan: types.Int64Null(),
+				ObjectSizeLessThan:    types.Int64Null(),
+			}
+			switch {
+			case rule.Filter.And != nil:
+				ruleModel.Filter.And = mapLifecycleFilterAnd(rule.Filter.And)
+			case rule.Filter.ObjectSizeGreaterThan > 0:
+				ruleModel.Filter.ObjectSizeGreaterThan = types.Int64Value(rule.Filter.ObjectSizeGreaterThan)
+			case rule.Filter.ObjectSizeLessThan > 0:
+				ruleModel.Filter.ObjectSizeLessThan = types.Int64Value(rule.Filter.ObjectSizeLessThan)
+			default:
+				ruleModel.Filter.Prefix = types.StringValue(rule.Filter.Prefix)
 			}
 		}
 
@@ -300,7 +605,18 @@ func mapLifecycleRules(lifecycleConfig *utils.LifecycleConfiguration) []Lifecycl
 		// Handle noncurrent version expiration
 		if rule.NoncurrentVersionExpiration != nil {
 			ruleModel.NoncurrentVersionExpiration = &LifecycleNoncurrentVersionResourceModel{
-				NoncurrentDays: types.Int64Value(int64(rule.NoncurrentVersionExpiration.NoncurrentDays)),
+				NoncurrentDays:          types.Int64Value(int64(rule.NoncurrentVersionExpiration.NoncurrentDays)),
+				NewerNoncurrentVersions: types.Int64Null(),
+			}
+			if rule.NoncurrentVersionExpiration.NewerNoncurrentVersions > 0 {
+				ruleModel.NoncurrentVersionExpiration.NewerNoncurrentVersions = types.Int64Value(int64(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions))
+			}
+		}
+
+		// Handle incomplete multipart upload abortion
+		if rule.AbortIncompleteMultipartUpload != nil {
+			ruleModel.AbortIncompleteMultipartUpload = &LifecycleAbortMultipartUploadResourceModel{
+				DaysAfterInitiation: types.Int64Value(int64(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
 			}
 		}
 
@@ -310,7 +626,80 @@ func mapLifecycleRules(lifecycleConfig *utils.LifecycleConfiguration) []Lifecycl
 	return rules
 }
 
+// mapLifecycleFilterAnd converts the And element of a filter into the Terraform model.
+// Unset predicates are null, as in a configuration that omits them.
+func mapLifecycleFilterAnd(and *utils.FilterAnd) *LifecycleFilterAndResourceModel {
+	model := &LifecycleFilterAndResourceModel{
+		Prefix:                types.StringNull(),
+		ObjectSizeGreaterThan: types.Int64Null(),
+		ObjectSizeLessThan:    types.Int64Null(),
+	}
+	if and.Prefix != "" {
+		model.Prefix = types.StringValue(and.Prefix)
+	}
+	if and.ObjectSizeGreaterThan > 0 {
+		model.ObjectSizeGreaterThan = types.Int64Value(and.ObjectSizeGreaterThan)
+	}
+	if and.ObjectSizeLessThan > 0 {
+		model.ObjectSizeLessThan = types.Int64Value(and.ObjectSizeLessThan)
+	}
+	if len(and.Tags) > 0 {
+		model.Tags = make(map[string]types.String, len(and.Tags))
+		for _, tag := range and.Tags {
+			model.Tags[tag.Key] = types.StringValue(tag.Value)
+		}
+	}
+	return model
+}
+
+// orderLifecycleRules orders rules read from the bucket like the rules in prior, matching
+// them by ID, so that StorageGrid returning the rules in another order does not show as
+// a change. Rules that are not in prior follow in the order they were read.
+func orderLifecycleRules(rules, prior []LifecycleRuleResourceModel) []LifecycleRuleResourceModel {
+	position := make(map[string]int, len(prior))
+	for i, rule := range prior {
+		if !rule.ID.IsNull() && !rule.ID.IsUnknown() {
+			position[rule.ID.ValueString()] = i
+		}
+	}
+
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b LifecycleRuleResourceModel) int {
+		i, aKnown := position[a.ID.ValueString()]
+		j, bKnown := position[b.ID.ValueString()]
+		switch {
+		case aKnown && bKnown:
+			return i - j
+		case aKnown:
+			return -1
+		case bKnown:
+			return 1
+		}
+		return 0
+	})
+	return ordered
+}
+
+// applyLifecycleRules writes the rules to the bucket. An empty rule list removes
+// the lifecycle configuration from the bucket while keeping the resource, since
+// the S3 API rejects a configuration without rules.
+func (r *S3BucketLifecycleConfigurationResource) applyLifecycleRules(ctx context.Context, bucketName string, rules []LifecycleRuleResourceModel) error {
+	if len(rules) == 0 {
+		return r.client.DeleteS3BucketLifecycleConfiguration(ctx, bucketName)
+	}
+
+	// Convert Terraform model to API model
+	lifecycleConfig := buildLifecycleConfiguration(rules)
+
+	return r.client.PutS3BucketLifecycleConfiguration(ctx, bucketName, lifecycleConfig)
+}
+
 func (r *S3BucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketLifecycleConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -318,12 +707,16 @@ func (r *S3BucketLifecycleConfigurationResource) Create(ctx context.Context, req
 		return
 	}
 
-	bucketName := plan.BucketName.ValueString()
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Create, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Convert Terraform model to API model
-	lifecycleConfig := buildLifecycleConfiguration(plan.Rules)
+	bucketName := plan.BucketName.ValueString()
 
-	err := r.client.PutS3BucketLifecycleConfiguration(bucketName, lifecycleConfig)
+	err := r.applyLifecycleRules(ctx, bucketName, plan.Rules)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Create S3 Bucket Lifecycle Configuration for %s", bucketName),
@@ -348,7 +741,7 @@ func (r *S3BucketLifecycleConfigurationResource) Read(ctx context.Context, req r
 	}
 
 	bucketName := state.BucketName.ValueString()
-	lifecycleConfig, err := r.client.GetS3BucketLifecycleConfiguration(bucketName)
+	lifecycleConfig, err := r.client.GetS3BucketLifecycleConfiguration(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket Lifecycle Configuration for %s", bucketName),
@@ -358,13 +751,18 @@ func (r *S3BucketLifecycleConfigurationResource) Read(ctx context.Context, req r
 	}
 
 	// Convert API model to Terraform model
-	state.Rules = mapLifecycleRules(lifecycleConfig)
+	state.Rules = orderLifecycleRules(mapLifecycleRules(lifecycleConfig), state.Rules)
 	state.ID = types.StringValue(bucketName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *S3BucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketLifecycleConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -372,12 +770,16 @@ func (r *S3BucketLifecycleConfigurationResource) Update(ctx context.Context, req
 		return
 	}
 
-	bucketName := plan.BucketName.ValueString()
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Update, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Convert Terraform model to API model
-	lifecycleConfig := buildLifecycleConfiguration(plan.Rules)
+	bucketName := plan.BucketName.ValueString()
 
-	err := r.client.PutS3BucketLifecycleConfiguration(bucketName, lifecycleConfig)
+	err := r.applyLifecycleRules(ctx, bucketName, plan.Rules)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Update S3 Bucket Lifecycle Configuration for %s", bucketName),
@@ -391,6 +793,11 @@ func (r *S3BucketLifecycleConfigurationResource) Update(ctx context.Context, req
 }
 
 func (r *S3BucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state S3BucketLifecycleConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -398,9 +805,16 @@ func (r *S3BucketLifecycleConfigurationResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel, diags := operationContext(ctx, state.Timeouts.Delete, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.BucketName.ValueString()
 
-	err := r.client.DeleteS3BucketLifecycleConfiguration(bucketName)
+	err := r.client.DeleteS3BucketLifecycleConfiguration(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Delete S3 Bucket Lifecycle Configuration for %s", bucketName),
@@ -417,7 +831,7 @@ func (r *S3BucketLifecycleConfigurationResource) ImportState(ctx context.Context
 	bucketName := req.ID
 
 	// Validate that the bucket exists and get lifecycle configuration
-	lifecycleConfig, err := r.client.GetS3BucketLifecycleConfiguration(bucketName)
+	lifecycleConfig, err := r.client.GetS3BucketLifecycleConfiguration(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Import S3 Bucket Lifecycle Configuration for %s", bucketName),
@@ -431,6 +845,7 @@ func (r *S3BucketLifecycleConfigurationResource) ImportState(ctx context.Context
 		BucketName: types.StringValue(bucketName),
 		Rules:      mapLifecycleRules(lifecycleConfig),
 		ID:         types.StringValue(bucketName),
+		Timeouts:   nullTimeouts(),
 	}
 
 	// Set the state
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -192,6 +193,49 @@ func TestBuildLifecycleConfiguration(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "noncurrent version expiration keeping newer versions",
+			rules: []LifecycleRuleResourceModel{
+				{
+					ID:     types.StringValue("rule-1"),
+					Status: types.StringValue("Enabled"),
+					NoncurrentVersionExpiration: &LifecycleNoncurrentVersionResourceModel{
+						NoncurrentDays:          types.Int64Value(30),
+						NewerNoncurrentVersions: types.Int64Value(5),
+					},
+				},
+			},
+			want: &utils.LifecycleConfiguration{
+				Rules: []utils.Rule{
+					{
+						ID:                          "rule-1",
+						Status:                      "Enabled",
+						NoncurrentVersionExpiration: &utils.NoncurrentVersionExpiration{NoncurrentDays: 30, NewerNoncurrentVersions: 5},
+					},
+				},
+			},
+		},
+		{
+			name: "abort incomplete multipart upload",
+			rules: []LifecycleRuleResourceModel{
+				{
+					ID:     types.StringValue("rule-1"),
+					Status: types.StringValue("Enabled"),
+					AbortIncompleteMultipartUpload: &LifecycleAbortMultipartUploadResourceModel{
+						DaysAfterInitiation: types.Int64Value(3),
+					},
+				},
+			},
+			want: &utils.LifecycleConfiguration{
+				Rules: []utils.Rule{
+					{
+						ID:                             "rule-1",
+						Status:                         "Enabled",
+						AbortIncompleteMultipartUpload: &utils.AbortIncompleteMultipartUpload{DaysAfterInitiation: 3},
+					},
+				},
+			},
+		},
 		{
 			name: "multiple rules preserve order and per-rule shape",
 			rules: []LifecycleRuleResourceModel{
@@ -347,6 +391,41 @@ func TestMapLifecycleRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "noncurrent version expiration keeping newer versions",
+			config: &utils.LifecycleConfiguration{
+				Rules: []utils.Rule{
+					{ID: "rule-1", Status: "Enabled", NoncurrentVersionExpiration: &utils.NoncurrentVersionExpiration{NoncurrentDays: 30, NewerNoncurrentVersions: 5}},
+				},
+			},
+			want: []LifecycleRuleResourceModel{
+				{
+					ID:     types.StringValue("rule-1"),
+					Status: types.StringValue("Enabled"),
+					NoncurrentVersionExpiration: &LifecycleNoncurrentVersionResourceModel{
+						NoncurrentDays:          types.Int64Value(30),
+						NewerNoncurrentVersions: types.Int64Value(5),
+					},
+				},
+			},
+		},
+		{
+			name: "abort incomplete multipart upload",
+			config: &utils.LifecycleConfiguration{
+				Rules: []utils.Rule{
+					{ID: "rule-1", Status: "Enabled", AbortIncompleteMultipartUpload: &utils.AbortIncompleteMultipartUpload{DaysAfterInitiation: 3}},
+				},
+			},
+			want: []LifecycleRuleResourceModel{
+				{
+					ID:     types.StringValue("rule-1"),
+					Status: types.StringValue("Enabled"),
+					AbortIncompleteMultipartUpload: &LifecycleAbortMultipartUploadResourceModel{
+						DaysAfterInitiation: types.Int64Value(3),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -463,6 +542,9 @@ func assertLifecycleConfigEqual(t *testing.T, got, want *utils.LifecycleConfigur
 		assertFilterEqual(t, i, g.Filter, w.Filter)
 		assertExpirationEqual(t, i, g.Expiration, w.Expiration)
 		assertNoncurrentEqual(t, i, g.NoncurrentVersionExpiration, w.NoncurrentVersionExpiration)
+		if !reflect.DeepEqual(g.AbortIncompleteMultipartUpload, w.AbortIncompleteMultipartUpload) {
+			t.Errorf("rule[%d] AbortIncompleteMultipartUpload = %+v, want %+v", i, g.AbortIncompleteMultipartUpload, w.AbortIncompleteMultipartUpload)
+		}
 	}
 }
 
@@ -511,6 +593,9 @@ func assertNoncurrentEqual(t *testing.T, i int, got, want *utils.NoncurrentVersi
 	if got != nil && got.NoncurrentDays != want.NoncurrentDays {
 		t.Errorf("rule[%d] NoncurrentVersionExpiration.NoncurrentDays = %d, want %d", i, got.NoncurrentDays, want.NoncurrentDays)
 	}
+	if got != nil && got.NewerNoncurrentVersions != want.NewerNoncurrentVersions {
+		t.Errorf("rule[%d] NoncurrentVersionExpiration.NewerNoncurrentVersions = %d, want %d", i, got.NewerNoncurrentVersions, want.NewerNoncurrentVersions)
+	}
 }
 
 // assertRuleModelsEqual compares two slices of Terraform rule models field by field.
@@ -555,6 +640,251 @@ func assertRuleModelsEqual(t *testing.T, got, want []LifecycleRuleResourceModel)
 			!g.NoncurrentVersionExpiration.NoncurrentDays.Equal(w.NoncurrentVersionExpiration.NoncurrentDays) {
 			t.Errorf("rule[%d] NoncurrentVersionExpiration.NoncurrentDays = %v, want %v",
 				i, g.NoncurrentVersionExpiration.NoncurrentDays, w.NoncurrentVersionExpiration.NoncurrentDays)
+		} else if g.NoncurrentVersionExpiration != nil &&
+			!g.NoncurrentVersionExpiration.NewerNoncurrentVersions.Equal(w.NoncurrentVersionExpiration.NewerNoncurrentVersions) {
+			t.Errorf("rule[%d] NoncurrentVersionExpiration.NewerNoncurrentVersions = %v, want %v",
+				i, g.NoncurrentVersionExpiration.NewerNoncurrentVersions, w.NoncurrentVersionExpiration.NewerNoncurrentVersions)
 		}
+
+		if !reflect.DeepEqual(g.AbortIncompleteMultipartUpload, w.AbortIncompleteMultipartUpload) {
+			t.Errorf("rule[%d] AbortIncompleteMultipartUpload = %+v, want %+v", i, g.AbortIncompleteMultipartUpload, w.AbortIncompleteMultipartUpload)
+		}
+	}
+}
+
+func TestBuildAndMapAndFilter(t *testing.T) {
+	original := []LifecycleRuleResourceModel{
+		{
+			ID:     types.StringValue("large-reports"),
+			Status: types.StringValue("Enabled"),
+			Filter: &LifecycleFilterResourceModel{
+				Prefix: types.StringNull(),
+				And: &LifecycleFilterAndResourceModel{
+					Prefix:                types.StringValue("reports/"),
+					Tags:                  map[string]types.String{"team": types.StringValue("finance"), "class": types.StringValue("archive")},
+					ObjectSizeGreaterThan: types.Int64Value(1048576),
+					ObjectSizeLessThan:    types.Int64Null(),
+				},
+			},
+		},
+	}
+
+	config := buildLifecycleConfiguration(original)
+	want := &utils.FilterAnd{
+		Prefix:                "reports/",
+		Tags:                  []utils.FilterTag{{Key: "class", Value: "archive"}, {Key: "team", Value: "finance"}},
+		ObjectSizeGreaterThan: 1048576,
+	}
+	if got := config.Rules[0].Filter.And; !reflect.DeepEqual(got, want) {
+		t.Fatalf("buildLifecycleConfiguration() And = %+v, want %+v", got, want)
+	}
+
+	// StorageGrid returns an And filter without a top-level prefix
+	config.Rules[0].Filter.Prefix = ""
+	roundTripped := mapLifecycleRules(config)
+	if !reflect.DeepEqual(roundTripped[0].Filter, original[0].Filter) {
+		t.Fatalf("mapLifecycleRules() Filter = %+v, want %+v", roundTripped[0].Filter, original[0].Filter)
+	}
+}
+
+func TestBuildAndMapObjectSizeFilter(t *testing.T) {
+	original := []LifecycleRuleResourceModel{
+		{
+			ID:     types.StringValue("large-noncurrent"),
+			Status: types.StringValue("Enabled"),
+			Filter: &LifecycleFilterResourceModel{
+				Prefix:                types.StringNull(),
+				ObjectSizeGreaterThan: types.Int64Value(104857600),
+				ObjectSizeLessThan:    types.Int64Null(),
+			},
+			NoncurrentVersionExpiration: &LifecycleNoncurrentVersionResourceModel{NoncurrentDays: types.Int64Value(7)},
+		},
+	}
+
+	config := buildLifecycleConfiguration(original)
+	if want := (&utils.Filter{ObjectSizeGreaterThan: 104857600}); !reflect.DeepEqual(config.Rules[0].Filter, want) {
+		t.Fatalf("buildLifecycleConfiguration() Filter = %+v, want %+v", config.Rules[0].Filter, want)
+	}
+
+	roundTripped := mapLifecycleRules(config)
+	if !reflect.DeepEqual(roundTripped[0].Filter, original[0].Filter) {
+		t.Fatalf("mapLifecycleRules() Filter = %+v, want %+v", roundTripped[0].Filter, original[0].Filter)
+	}
+}
+
+func TestFilterAndValidator(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{
+		"prefix":                   types.StringType,
+		"tags":                     types.MapType{ElemType: types.StringType},
+		"object_size_greater_than": types.Int64Type,
+		"object_size_less_than":    types.Int64Type,
+	}
+	value := func(prefix types.String, greaterThan, lessThan types.Int64) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"prefix":                   prefix,
+			"tags":                     types.MapNull(types.StringType),
+			"object_size_greater_than": greaterThan,
+			"object_size_less_than":    lessThan,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		value     types.Object
+		wantError bool
+	}{
+		{
+			name:  "null and block is valid",
+			value: types.ObjectNull(attrTypes),
+		},
+		{
+			name:  "prefix and size bounds are valid",
+			value: value(types.StringValue("logs/"), types.Int64Value(1024), types.Int64Value(4096)),
+		},
+		{
+			name:      "empty and block is invalid",
+			value:     value(types.StringNull(), types.Int64Null(), types.Int64Null()),
+			wantError: true,
+		},
+		{
+			name:      "size bounds without a range are invalid",
+			value:     value(types.StringNull(), types.Int64Value(4096), types.Int64Value(4096)),
+			wantError: true,
+		},
+		{
+			name:  "unknown predicates are valid",
+			value: value(types.StringUnknown(), types.Int64Null(), types.Int64Null()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ObjectRequest{
+				Path:        path.Root("rule").AtListIndex(0).AtName("filter").AtName("and"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.ObjectResponse{}
+
+			filterAndValidator{}.ValidateObject(ctx, req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %v, want %v (diagnostics: %v)", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNonEmptyFilterValidatorAcceptsAnd(t *testing.T) {
+	andTypes := map[string]attr.Type{"prefix": types.StringType}
+	attrTypes := map[string]attr.Type{"prefix": types.StringType, "and": types.ObjectType{AttrTypes: andTypes}}
+	value := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"prefix": types.StringNull(),
+		"and":    types.ObjectValueMust(andTypes, map[string]attr.Value{"prefix": types.StringValue("logs/")}),
+	})
+
+	resp := &validator.ObjectResponse{}
+	nonEmptyFilterValidator{}.ValidateObject(context.Background(), validator.ObjectRequest{Path: path.Root("filter"), ConfigValue: value}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics for a filter with an and block: %v", resp.Diagnostics)
+	}
+}
+
+func TestNonEmptyFilterValidatorAcceptsObjectSize(t *testing.T) {
+	attrTypes := map[string]attr.Type{"prefix": types.StringType, "object_size_greater_than": types.Int64Type, "object_size_less_than": types.Int64Type}
+	value := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"prefix":                   types.StringNull(),
+		"object_size_greater_than": types.Int64Null(),
+		"object_size_less_than":    types.Int64Value(4096),
+	})
+
+	resp := &validator.ObjectResponse{}
+	nonEmptyFilterValidator{}.ValidateObject(context.Background(), validator.ObjectRequest{Path: path.Root("filter"), ConfigValue: value}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics for a filter with an object size bound: %v", resp.Diagnostics)
+	}
+}
+
+func TestOrderLifecycleRules(t *testing.T) {
+	rule := func(id string) LifecycleRuleResourceModel {
+		return LifecycleRuleResourceModel{ID: types.StringValue(id), Status: types.StringValue("Enabled")}
+	}
+	read := []LifecycleRuleResourceModel{rule("new"), rule("logs"), rule("temp")}
+	prior := []LifecycleRuleResourceModel{rule("temp"), rule("logs")}
+
+	got := orderLifecycleRules(read, prior)
+	var ids []string
+	for _, r := range got {
+		ids = append(ids, r.ID.ValueString())
+	}
+	if want := []string{"temp", "logs", "new"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("orderLifecycleRules() IDs = %v, want %v", ids, want)
+	}
+}
+
+func TestValidateLifecycleRules(t *testing.T) {
+	expiration := func(days types.Int64, date types.String) *LifecycleExpirationResourceModel {
+		return &LifecycleExpirationResourceModel{Days: days, Date: date, ExpiredObjectDeleteMarker: types.BoolNull()}
+	}
+	rule := func(id string, exp *LifecycleExpirationResourceModel) LifecycleRuleResourceModel {
+		return LifecycleRuleResourceModel{ID: types.StringValue(id), Status: types.StringValue("Enabled"), Expiration: exp}
+	}
+
+	tests := []struct {
+		name      string
+		rules     []LifecycleRuleResourceModel
+		wantError bool
+	}{
+		{
+			name:  "valid rules",
+			rules: []LifecycleRuleResourceModel{rule("logs", expiration(types.Int64Value(30), types.StringNull())), rule("archive", expiration(types.Int64Null(), types.StringValue("2026-12-31T00:00:00.000Z")))},
+		},
+		{
+			name:      "duplicate rule IDs",
+			rules:     []LifecycleRuleResourceModel{rule("logs", expiration(types.Int64Value(30), types.StringNull())), rule("logs", expiration(types.Int64Value(7), types.StringNull()))},
+			wantError: true,
+		},
+		{
+			name:      "rule without an action",
+			rules:     []LifecycleRuleResourceModel{rule("logs", nil)},
+			wantError: true,
+		},
+		{
+			name:      "empty expiration",
+			rules:     []LifecycleRuleResourceModel{rule("logs", expiration(types.Int64Null(), types.StringNull()))},
+			wantError: true,
+		},
+		{
+			name:      "date not at midnight",
+			rules:     []LifecycleRuleResourceModel{rule("logs", expiration(types.Int64Null(), types.StringValue("2026-12-31T12:00:00.000Z")))},
+			wantError: true,
+		},
+		{
+			name:      "date in another format",
+			rules:     []LifecycleRuleResourceModel{rule("logs", expiration(types.Int64Null(), types.StringValue("2026-12-31")))},
+			wantError: true,
+		},
+		{
+			name:  "unknown date",
+			rules: []LifecycleRuleResourceModel{rule("logs", expiration(types.Int64Null(), types.StringUnknown()))},
+		},
+		{
+			name: "noncurrent expiration without days",
+			rules: []LifecycleRuleResourceModel{{
+				ID:                          types.StringValue("logs"),
+				Status:                      types.StringValue("Enabled"),
+				NoncurrentVersionExpiration: &LifecycleNoncurrentVersionResourceModel{NoncurrentDays: types.Int64Null()},
+			}},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateLifecycleRules(tt.rules)
+			if got := diags.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %v, want %v (diagnostics: %v)", got, tt.wantError, diags)
+			}
+		})
 	}
 }
@@ -0,0 +1,418 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3BucketNotificationResource{}
+	_ resource.ResourceWithConfigure   = &S3BucketNotificationResource{}
+	_ resource.ResourceWithImportState = &S3BucketNotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &S3BucketNotificationResource{}
+)
+
+func NewS3BucketNotificationResource() resource.Resource {
+	return &S3BucketNotificationResource{}
+}
+
+// S3BucketNotificationResource defines the resource implementation.
+type S3BucketNotificationResource struct {
+	client *utils.Client
+}
+
+// S3BucketNotificationResourceModel describes the resource data model.
+type S3BucketNotificationResourceModel struct {
+	BucketName types.String             `tfsdk:"bucket_name"`
+	Topics     []NotificationTopicModel `tfsdk:"topic"`
+	ID         types.String             `tfsdk:"id"`
+}
+
+// NotificationTopicModel represents a notification topic of a bucket.
+type NotificationTopicModel struct {
+	ID           types.String   `tfsdk:"id"`
+	TopicURN     types.String   `tfsdk:"topic_urn"`
+	Events       []types.String `tfsdk:"events"`
+	FilterPrefix types.String   `tfsdk:"filter_prefix"`
+	FilterSuffix types.String   `tfsdk:"filter_suffix"`
+}
+
+func (r *S3BucketNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_notification"
+}
+
+func (r *S3BucketNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the event notifications of a StorageGrid S3 bucket, which platform services send to SNS compatible endpoints. " +
+			"The endpoints must be configured for the tenant, and platform services must be allowed for the tenant account.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to send event notifications for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the notification configuration (same as bucket_name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"topic": schema.ListNestedBlock{
+				Description: "Notification topics of the bucket. Declaring no topics disables the event notifications of the bucket while keeping the resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier for the topic configuration. Generated by StorageGrid when not set.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"topic_urn": schema.StringAttribute{
+							Description: "The URN of the platform services endpoint to send the notifications to, such as `arn:aws:sns:us-east-1:123456789012:uploads`. " +
+								"The endpoint must exist, which is checked at plan time.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"events": schema.ListAttribute{
+							Description: "The events to send notifications for, such as `s3:ObjectCreated:*` or `s3:ObjectRemoved:Delete`.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(utils.BucketNotificationEvents...)),
+							},
+						},
+						"filter_prefix": schema.StringAttribute{
+							Description: "Only send notifications for object keys that start with this prefix.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"filter_suffix": schema.StringAttribute{
+							Description: "Only send notifications for object keys that end with this suffix.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *S3BucketNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan checks that the endpoints of the topics exist, so that a misspelled URN
+// fails the plan instead of the apply.
+func (r *S3BucketNotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// The topics are read as a list, as other attributes of the topics may still be unknown
+	var topics types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("topic"), &topics)...)
+	if resp.Diagnostics.HasError() || topics.IsUnknown() || len(topics.Elements()) == 0 {
+		return
+	}
+
+	urns := make([]types.String, len(topics.Elements()))
+	for i, element := range topics.Elements() {
+		urns[i] = types.StringUnknown()
+		if topic, ok := element.(types.Object); ok {
+			if urn, ok := topic.Attributes()["topic_urn"].(types.String); ok {
+				urns[i] = urn
+			}
+		}
+	}
+
+	endpoints, err := r.client.GetPlatformServiceEndpoints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Platform Services Endpoints",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkNotificationEndpoints(urns, endpoints)...)
+}
+
+// checkNotificationEndpoints returns an error for each topic URN that is not the URN of
+// one of the endpoints. Unknown URNs are skipped.
+func checkNotificationEndpoints(urns []types.String, endpoints []utils.PlatformServiceEndpointData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, value := range urns {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+
+		urn := value.ValueString()
+		if !slices.ContainsFunc(endpoints, func(e utils.PlatformServiceEndpointData) bool { return e.EndpointURN == urn }) {
+			diags.AddAttributeError(
+				path.Root("topic").AtListIndex(i).AtName("topic_urn"),
+				"Platform Services Endpoint Not Found",
+				fmt.Sprintf("The tenant has no platform services endpoint with URN %s. Configure the endpoint in the Tenant Manager first, "+
+					"or use the storagegrid_s3_bucket_platform_services data source to list the endpoints of a bucket.", urn),
+			)
+		}
+	}
+	return diags
+}
+
+// buildNotificationTopics converts the Terraform topic models into the API model.
+func buildNotificationTopics(topics []NotificationTopicModel) []utils.BucketNotificationTopic {
+	notificationTopics := make([]utils.BucketNotificationTopic, len(topics))
+	for i, topic := range topics {
+		notificationTopics[i] = utils.BucketNotificationTopic{
+			ID:       topic.ID.ValueString(),
+			TopicURN: topic.TopicURN.ValueString(),
+			Events:   stringValues(topic.Events),
+			Prefix:   topic.FilterPrefix.ValueString(),
+			Suffix:   topic.FilterSuffix.ValueString(),
+		}
+	}
+
+	return notificationTopics
+}
+
+// mapNotificationTopics converts the API model into the Terraform topic models.
+func mapNotificationTopics(notificationTopics []utils.BucketNotificationTopic) []NotificationTopicModel {
+	var topics []NotificationTopicModel
+	for _, topic := range notificationTopics {
+		topicModel := NotificationTopicModel{
+			ID:           types.StringNull(),
+			TopicURN:     types.StringValue(topic.TopicURN),
+			Events:       stringModels(topic.Events),
+			FilterPrefix: types.StringNull(),
+			FilterSuffix: types.StringNull(),
+		}
+		if topic.ID != "" {
+			topicModel.ID = types.StringValue(topic.ID)
+		}
+		if topic.Prefix != "" {
+			topicModel.FilterPrefix = types.StringValue(topic.Prefix)
+		}
+		if topic.Suffix != "" {
+			topicModel.FilterSuffix = types.StringValue(topic.Suffix)
+		}
+
+		topics = append(topics, topicModel)
+	}
+
+	return topics
+}
+
+// applyNotificationTopics writes the topics to the bucket and fills in the IDs that
+// StorageGrid generated for topics without one.
+func (r *S3BucketNotificationResource) applyNotificationTopics(ctx context.Context, bucketName string, topics []NotificationTopicModel) error {
+	if err := r.client.PutS3BucketNotification(ctx, bucketName, buildNotificationTopics(topics)); err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(topics, func(t NotificationTopicModel) bool { return t.ID.IsUnknown() }) {
+		return nil
+	}
+
+	current, err := r.client.GetS3BucketNotification(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	for i := range topics {
+		if !topics[i].ID.IsUnknown() {
+			continue
+		}
+		topics[i].ID = types.StringNull()
+		if i < len(current) && current[i].ID != "" {
+			topics[i].ID = types.StringValue(current[i].ID)
+		}
+	}
+	return nil
+}
+
+func (r *S3BucketNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.applyNotificationTopics(ctx, bucketName, plan.Topics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Bucket Notification for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set the ID (same as bucket name)
+	plan.ID = types.StringValue(bucketName)
+
+	// Save the plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	topics, err := r.client.GetS3BucketNotification(ctx, bucketName)
+	if err != nil {
+		// The bucket was deleted outside of Terraform
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket Notification for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Convert API model to Terraform model
+	state.Topics = mapNotificationTopics(topics)
+	state.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3BucketNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.applyNotificationTopics(ctx, bucketName, plan.Topics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Bucket Notification for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the updated plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	// An empty configuration disables the notifications. A bucket that no longer
+	// exists counts as done.
+	err := r.client.PutS3BucketNotification(ctx, bucketName, nil)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete S3 Bucket Notification for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// State is automatically cleared on successful delete
+}
+
+func (r *S3BucketNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the bucket name as the identifier
+	bucketName := req.ID
+
+	topics, err := r.client.GetS3BucketNotification(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Bucket Notification for %s", bucketName),
+			fmt.Sprintf("Bucket does not exist or notification configuration is not accessible: %s", err.Error()),
+		)
+		return
+	}
+
+	state := S3BucketNotificationResourceModel{
+		BucketName: types.StringValue(bucketName),
+		Topics:     mapNotificationTopics(topics),
+		ID:         types.StringValue(bucketName),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Set the ID attribute explicitly for import
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestCheckNotificationEndpoints(t *testing.T) {
+	endpoints := []utils.PlatformServiceEndpointData{
+		{ID: "1", EndpointURN: "arn:aws:sns:us-east-1:123:uploads"},
+		{ID: "2", EndpointURN: "urn:sgws:s3:::mirror"},
+	}
+	urns := []types.String{
+		types.StringValue("arn:aws:sns:us-east-1:123:uploads"),
+		types.StringUnknown(),
+		types.StringValue("arn:aws:sns:us-east-1:123:missing"),
+	}
+
+	diags := checkNotificationEndpoints(urns, endpoints)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("checkNotificationEndpoints() = %v, want one error", diags)
+	}
+	want := path.Root("topic").AtListIndex(2).AtName("topic_urn")
+	if got, ok := diags[0].(interface{ Path() path.Path }); !ok || !got.Path().Equal(want) {
+		t.Fatalf("checkNotificationEndpoints() error %v, want it at %s", diags[0], want)
+	}
+}
+
+func TestBuildAndMapNotificationTopics(t *testing.T) {
+	topics := []NotificationTopicModel{
+		{
+			ID:           types.StringValue("uploads"),
+			TopicURN:     types.StringValue("arn:aws:sns:us-east-1:123:uploads"),
+			Events:       []types.String{types.StringValue("s3:ObjectCreated:*")},
+			FilterPrefix: types.StringValue("incoming/"),
+			FilterSuffix: types.StringNull(),
+		},
+		{
+			ID:           types.StringNull(),
+			TopicURN:     types.StringValue("arn:aws:sns:us-east-1:123:deletes"),
+			Events:       []types.String{types.StringValue("s3:ObjectRemoved:Delete")},
+			FilterPrefix: types.StringNull(),
+			FilterSuffix: types.StringValue(".csv"),
+		},
+	}
+
+	want := []utils.BucketNotificationTopic{
+		{ID: "uploads", TopicURN: "arn:aws:sns:us-east-1:123:uploads", Events: []string{"s3:ObjectCreated:*"}, Prefix: "incoming/"},
+		{TopicURN: "arn:aws:sns:us-east-1:123:deletes", Events: []string{"s3:ObjectRemoved:Delete"}, Suffix: ".csv"},
+	}
+	if got := buildNotificationTopics(topics); !reflect.DeepEqual(got, want) {
+		t.Fatalf("buildNotificationTopics() = %+v, want %+v", got, want)
+	}
+
+	if got := mapNotificationTopics(buildNotificationTopics(topics)); !reflect.DeepEqual(got, topics) {
+		t.Fatalf("mapNotificationTopics() = %+v, want %+v", got, topics)
+	}
+}
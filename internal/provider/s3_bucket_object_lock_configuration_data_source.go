@@ -88,16 +88,16 @@ func (d *S3BucketObjectLockConfigurationDataSource) Configure(ctx context.Contex
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *S3BucketObjectLockConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -109,7 +109,7 @@ func (d *S3BucketObjectLockConfigurationDataSource) Read(ctx context.Context, re
 	}
 
 	bucketName := state.BucketName.ValueString()
-	objectLock, err := d.client.GetS3BucketObjectLock(bucketName)
+	objectLock, err := d.client.GetS3BucketObjectLock(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket Object Lock Configuration for %s", bucketName),
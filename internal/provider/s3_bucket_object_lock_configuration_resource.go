@@ -6,24 +6,28 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &S3BucketObjectLockConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &S3BucketObjectLockConfigurationResource{}
-	_ resource.ResourceWithImportState = &S3BucketObjectLockConfigurationResource{}
+	_ resource.Resource                   = &S3BucketObjectLockConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &S3BucketObjectLockConfigurationResource{}
+	_ resource.ResourceWithImportState    = &S3BucketObjectLockConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &S3BucketObjectLockConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &S3BucketObjectLockConfigurationResource{}
 )
 
 func NewS3BucketObjectLockConfigurationResource() resource.Resource {
@@ -40,6 +44,7 @@ type S3BucketObjectLockConfigurationResourceModel struct {
 	BucketName              types.String                          `tfsdk:"bucket_name"`
 	DefaultRetentionSetting *DefaultRetentionSettingResourceModel `tfsdk:"default_retention_setting"`
 	ID                      types.String                          `tfsdk:"id"`
+	Timeouts                timeouts.Value                        `tfsdk:"timeouts"`
 }
 
 // DefaultRetentionSettingResourceModel represents default retention settings for the resource.
@@ -75,6 +80,7 @@ func (r *S3BucketObjectLockConfigurationResource) Schema(ctx context.Context, re
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 			"default_retention_setting": schema.SingleNestedBlock{
 				Description: "Default retention settings for object lock.",
 				Attributes: map[string]schema.Attribute{
@@ -83,18 +89,24 @@ func (r *S3BucketObjectLockConfigurationResource) Schema(ctx context.Context, re
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("compliance"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("compliance", "governance"),
+						},
 					},
 					"days": schema.Int64Attribute{
-						Description: "Retention period in days.",
+						Description: "Retention period in days. Conflicts with years.",
 						Optional:    true,
-						Computed:    true,
-						Default:     int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("years")),
+						},
 					},
 					"years": schema.Int64Attribute{
-						Description: "Retention period in years.",
+						Description: "Retention period in years. Conflicts with days.",
 						Optional:    true,
-						Computed:    true,
-						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 				},
 			},
@@ -107,19 +119,127 @@ func (r *S3BucketObjectLockConfigurationResource) Configure(ctx context.Context,
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ValidateConfig checks that default_retention_setting sets a retention period.
+func (r *S3BucketObjectLockConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config S3BucketObjectLockConfigurationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DefaultRetentionSetting == nil {
+		return
+	}
+
+	retention := config.DefaultRetentionSetting
+	if retention.Days.IsNull() && retention.Years.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_retention_setting"),
+			"Missing Retention Period",
+			"default_retention_setting must set days or years.",
+		)
+	}
+}
+
+// buildDefaultRetentionSetting converts the Terraform model into the API model.
+func buildDefaultRetentionSetting(model *DefaultRetentionSettingResourceModel) *utils.DefaultRetentionSetting {
+	if model == nil {
+		return nil
+	}
+	return &utils.DefaultRetentionSetting{
+		Mode:  model.Mode.ValueString(),
+		Days:  int(model.Days.ValueInt64()),
+		Years: int(model.Years.ValueInt64()),
+	}
+}
+
+// mapDefaultRetentionSetting converts the API model into the Terraform model. The unset
+// retention period is null, as in the configuration.
+func mapDefaultRetentionSetting(setting *utils.DefaultRetentionSetting) *DefaultRetentionSettingResourceModel {
+	if setting == nil {
+		return nil
+	}
+	model := &DefaultRetentionSettingResourceModel{
+		Mode:  types.StringValue(setting.Mode),
+		Days:  types.Int64Null(),
+		Years: types.Int64Null(),
+	}
+	if setting.Years > 0 {
+		model.Years = types.Int64Value(int64(setting.Years))
+	} else {
+		model.Days = types.Int64Value(int64(setting.Days))
+	}
+	return model
+}
+
+// writeObjectLockConfiguration writes the default retention setting of plan to the bucket
+// and sets plan to the configuration read back, so that state holds what StorageGrid stored.
+func (r *S3BucketObjectLockConfigurationResource) writeObjectLockConfiguration(ctx context.Context, plan *S3BucketObjectLockConfigurationResourceModel) error {
+	bucketName := plan.BucketName.ValueString()
+	if err := r.client.UpdateS3BucketObjectLock(ctx, bucketName, true, buildDefaultRetentionSetting(plan.DefaultRetentionSetting)); err != nil {
+		return err
+	}
+
+	objectLock, err := r.client.GetS3BucketObjectLock(ctx, bucketName)
+	if err != nil {
+		return fmt.Errorf("error reading object lock configuration after update: %w", err)
+	}
+	plan.DefaultRetentionSetting = mapDefaultRetentionSetting(objectLock.DefaultRetentionSetting)
+	plan.ID = types.StringValue(bucketName)
+	return nil
+}
+
+// ModifyPlan verifies at plan time that object lock is enabled on the target bucket,
+// so a misconfigured bucket is reported before anything is applied.
+func (r *S3BucketObjectLockConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, for existing resources, or before the provider is configured
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var bucketName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("bucket_name"), &bucketName)...)
+	if resp.Diagnostics.HasError() || bucketName.IsUnknown() || bucketName.IsNull() {
+		return
+	}
+
+	bucket, err := r.client.GetS3Bucket(ctx, bucketName.ValueString())
+	if utils.IsNotFound(err) {
+		// The bucket may be created in the same apply, in which case Create performs the check
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("bucket_name"),
+			"Unable to Verify Object Lock on Bucket",
+			fmt.Sprintf("Could not read bucket %s to check that object lock is enabled, the check is repeated when the resource is created: %s", bucketName.ValueString(), err.Error()),
 		)
 		return
 	}
 
-	r.client = client
+	if bucket.S3ObjectLock == nil || !bucket.S3ObjectLock.Enabled {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bucket_name"),
+			"Object Lock Not Enabled on Bucket",
+			fmt.Sprintf("Bucket %s does not have object lock enabled. Object lock must be enabled at bucket creation time by setting object_lock_enabled = true on the storagegrid_s3_bucket resource. If the bucket is being replaced with object lock enabled, apply that change before adding this resource.", bucket.Name),
+		)
+	}
 }
 
 func (r *S3BucketObjectLockConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketObjectLockConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -127,10 +247,17 @@ func (r *S3BucketObjectLockConfigurationResource) Create(ctx context.Context, re
 		return
 	}
 
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Create, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := plan.BucketName.ValueString()
 
 	// Get current object lock status to validate this resource can be applied
-	currentObjectLock, err := r.client.GetS3BucketObjectLock(bucketName)
+	currentObjectLock, err := r.client.GetS3BucketObjectLock(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Check Current Object Lock Status for %s", bucketName),
@@ -148,24 +275,7 @@ func (r *S3BucketObjectLockConfigurationResource) Create(ctx context.Context, re
 		return
 	}
 
-	var defaultRetentionSetting *utils.DefaultRetentionSetting
-	if plan.DefaultRetentionSetting != nil {
-		defaultRetentionSetting = &utils.DefaultRetentionSetting{
-			Mode: plan.DefaultRetentionSetting.Mode.ValueString(),
-		}
-
-		// Only set days OR years, not both - StorageGrid requires exactly one
-		days := int(plan.DefaultRetentionSetting.Days.ValueInt64())
-		years := int(plan.DefaultRetentionSetting.Years.ValueInt64())
-
-		if years > 0 {
-			defaultRetentionSetting.Years = years
-		} else {
-			defaultRetentionSetting.Days = days
-		}
-	}
-
-	err = r.client.UpdateS3BucketObjectLock(bucketName, true, defaultRetentionSetting)
+	err = r.writeObjectLockConfiguration(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Create S3 Bucket Object Lock Configuration for %s", bucketName),
@@ -174,10 +284,6 @@ func (r *S3BucketObjectLockConfigurationResource) Create(ctx context.Context, re
 		return
 	}
 
-	// Set the ID (same as bucket name)
-	plan.ID = types.StringValue(bucketName)
-
-	// Save the plan to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -190,7 +296,7 @@ func (r *S3BucketObjectLockConfigurationResource) Read(ctx context.Context, req
 	}
 
 	bucketName := state.BucketName.ValueString()
-	objectLock, err := r.client.GetS3BucketObjectLock(bucketName)
+	objectLock, err := r.client.GetS3BucketObjectLock(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket Object Lock Configuration for %s", bucketName),
@@ -202,21 +308,18 @@ func (r *S3BucketObjectLockConfigurationResource) Read(ctx context.Context, req
 	// Update state with current values
 	state.ID = types.StringValue(bucketName)
 
-	// Handle default retention setting
-	if objectLock.DefaultRetentionSetting != nil {
-		state.DefaultRetentionSetting = &DefaultRetentionSettingResourceModel{
-			Mode:  types.StringValue(objectLock.DefaultRetentionSetting.Mode),
-			Days:  types.Int64Value(int64(objectLock.DefaultRetentionSetting.Days)),
-			Years: types.Int64Value(int64(objectLock.DefaultRetentionSetting.Years)),
-		}
-	} else {
-		state.DefaultRetentionSetting = nil
-	}
+	// Changes made in the Tenant Manager show as drift
+	state.DefaultRetentionSetting = mapDefaultRetentionSetting(objectLock.DefaultRetentionSetting)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *S3BucketObjectLockConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketObjectLockConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -224,26 +327,16 @@ func (r *S3BucketObjectLockConfigurationResource) Update(ctx context.Context, re
 		return
 	}
 
-	bucketName := plan.BucketName.ValueString()
-
-	var defaultRetentionSetting *utils.DefaultRetentionSetting
-	if plan.DefaultRetentionSetting != nil {
-		defaultRetentionSetting = &utils.DefaultRetentionSetting{
-			Mode: plan.DefaultRetentionSetting.Mode.ValueString(),
-		}
-
-		// Only set days OR years, not both - StorageGrid requires exactly one
-		days := int(plan.DefaultRetentionSetting.Days.ValueInt64())
-		years := int(plan.DefaultRetentionSetting.Years.ValueInt64())
-
-		if years > 0 {
-			defaultRetentionSetting.Years = years
-		} else {
-			defaultRetentionSetting.Days = days
-		}
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Update, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.UpdateS3BucketObjectLock(bucketName, true, defaultRetentionSetting)
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.writeObjectLockConfiguration(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Update S3 Bucket Object Lock Configuration for %s", bucketName),
@@ -252,11 +345,15 @@ func (r *S3BucketObjectLockConfigurationResource) Update(ctx context.Context, re
 		return
 	}
 
-	// Save the updated plan to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *S3BucketObjectLockConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state S3BucketObjectLockConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -264,16 +361,23 @@ func (r *S3BucketObjectLockConfigurationResource) Delete(ctx context.Context, re
 		return
 	}
 
+	ctx, cancel, diags := operationContext(ctx, state.Timeouts.Delete, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.BucketName.ValueString()
 
 	// When deleting object lock configuration, try to disable object lock
 	// but if that fails (which it often does), just clear default retention settings
-	err := r.client.UpdateS3BucketObjectLock(bucketName, false, nil)
+	err := r.client.UpdateS3BucketObjectLock(ctx, bucketName, false, nil)
 	if err != nil {
 		// Check if this is specifically an "Invalid ObjectLockEnabled value" error
-		if strings.Contains(err.Error(), "Invalid ObjectLockEnabled value") {
+		if utils.IsObjectLockDisableRejected(err) {
 			// Try to just clear the default retention settings instead
-			err2 := r.client.UpdateS3BucketObjectLock(bucketName, true, nil)
+			err2 := r.client.UpdateS3BucketObjectLock(ctx, bucketName, true, nil)
 			if err2 != nil {
 				resp.Diagnostics.AddWarning(
 					"Cannot Disable Object Lock",
@@ -303,7 +407,7 @@ func (r *S3BucketObjectLockConfigurationResource) ImportState(ctx context.Contex
 	bucketName := req.ID
 
 	// Validate that the bucket exists and get object lock configuration
-	objectLock, err := r.client.GetS3BucketObjectLock(bucketName)
+	objectLock, err := r.client.GetS3BucketObjectLock(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Import S3 Bucket Object Lock Configuration for %s", bucketName),
@@ -325,16 +429,11 @@ func (r *S3BucketObjectLockConfigurationResource) ImportState(ctx context.Contex
 	state := S3BucketObjectLockConfigurationResourceModel{
 		BucketName: types.StringValue(bucketName),
 		ID:         types.StringValue(bucketName),
+		Timeouts:   nullTimeouts(),
 	}
 
 	// Handle default retention setting
-	if objectLock.DefaultRetentionSetting != nil {
-		state.DefaultRetentionSetting = &DefaultRetentionSettingResourceModel{
-			Mode:  types.StringValue(objectLock.DefaultRetentionSetting.Mode),
-			Days:  types.Int64Value(int64(objectLock.DefaultRetentionSetting.Days)),
-			Years: types.Int64Value(int64(objectLock.DefaultRetentionSetting.Years)),
-		}
-	}
+	state.DefaultRetentionSetting = mapDefaultRetentionSetting(objectLock.DefaultRetentionSetting)
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestDefaultRetentionSettingRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		model *DefaultRetentionSettingResourceModel
+		want  *utils.DefaultRetentionSetting
+	}{
+		{
+			name:  "no default retention",
+			model: nil,
+			want:  nil,
+		},
+		{
+			name:  "days",
+			model: &DefaultRetentionSettingResourceModel{Mode: types.StringValue("compliance"), Days: types.Int64Value(30), Years: types.Int64Null()},
+			want:  &utils.DefaultRetentionSetting{Mode: "compliance", Days: 30},
+		},
+		{
+			name:  "years",
+			model: &DefaultRetentionSettingResourceModel{Mode: types.StringValue("governance"), Days: types.Int64Null(), Years: types.Int64Value(7)},
+			want:  &utils.DefaultRetentionSetting{Mode: "governance", Years: 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildDefaultRetentionSetting(tt.model)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("buildDefaultRetentionSetting() = %+v, want %+v", got, tt.want)
+			}
+			if roundTripped := mapDefaultRetentionSetting(got); !reflect.DeepEqual(roundTripped, tt.model) {
+				t.Fatalf("mapDefaultRetentionSetting() = %+v, want %+v", roundTripped, tt.model)
+			}
+		})
+	}
+}
+
+func TestS3BucketObjectLockConfigurationModifyPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantError   string
+		wantWarning string
+	}{
+		{
+			name: "object lock enabled",
+			body: `{"data": [{"name": "archive", "s3ObjectLock": {"enabled": true}}]}`,
+		},
+		{
+			name:      "object lock not enabled",
+			body:      `{"data": [{"name": "archive"}]}`,
+			wantError: "Object Lock Not Enabled on Bucket",
+		},
+		{
+			// The bucket is created in the same apply
+			name: "bucket not found",
+			body: `{"data": [{"name": "other"}]}`,
+		},
+		{
+			name:        "bucket list fails",
+			status:      http.StatusForbidden,
+			body:        `{"code": 403, "message": {"text": "access denied"}}`,
+			wantWarning: "Unable to Verify Object Lock on Bucket",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v4/org/containers" {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			r := &S3BucketObjectLockConfigurationResource{client: &utils.Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+			}}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+			attributes["bucket_name"] = tftypes.NewValue(tftypes.String, "archive")
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}, resp)
+
+			if tt.wantError == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan returned errors: %v", resp.Diagnostics)
+			}
+			if tt.wantError != "" && (!resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantError) {
+				t.Fatalf("ModifyPlan diagnostics = %v, want error %q", resp.Diagnostics, tt.wantError)
+			}
+			if warnings := resp.Diagnostics.Warnings(); tt.wantWarning != "" && (len(warnings) == 0 || warnings[0].Summary() != tt.wantWarning) {
+				t.Fatalf("ModifyPlan diagnostics = %v, want warning %q", resp.Diagnostics, tt.wantWarning)
+			} else if tt.wantWarning == "" && len(warnings) > 0 {
+				t.Fatalf("ModifyPlan returned warnings: %v", warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &S3BucketPlatformServicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &S3BucketPlatformServicesDataSource{}
+)
+
+func NewS3BucketPlatformServicesDataSource() datasource.DataSource {
+	return &S3BucketPlatformServicesDataSource{}
+}
+
+// S3BucketPlatformServicesDataSource defines the data source implementation.
+type S3BucketPlatformServicesDataSource struct {
+	client *utils.Client
+}
+
+// S3BucketPlatformServicesDataSourceModel describes the data source data model.
+type S3BucketPlatformServicesDataSourceModel struct {
+	BucketName               types.String                   `tfsdk:"bucket_name"`
+	ReplicationEnabled       types.Bool                     `tfsdk:"replication_enabled"`
+	ReplicationDestinations  []types.String                 `tfsdk:"replication_destinations"`
+	NotificationEnabled      types.Bool                     `tfsdk:"notification_enabled"`
+	NotificationDestinations []types.String                 `tfsdk:"notification_destinations"`
+	Endpoints                []PlatformServiceEndpointModel `tfsdk:"endpoints"`
+	HasErrors                types.Bool                     `tfsdk:"has_errors"`
+}
+
+// PlatformServiceEndpointModel represents an endpoint used by the bucket's platform services.
+type PlatformServiceEndpointModel struct {
+	URN           types.String `tfsdk:"urn"`
+	DisplayName   types.String `tfsdk:"display_name"`
+	URI           types.String `tfsdk:"uri"`
+	LastError     types.String `tfsdk:"last_error"`
+	LastErrorTime types.String `tfsdk:"last_error_time"`
+}
+
+func (d *S3BucketPlatformServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_platform_services"
+}
+
+func (d *S3BucketPlatformServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the platform services (CloudMirror replication and event notifications) status of a StorageGrid S3 bucket. " +
+			"StorageGrid does not expose queue backlog to tenant accounts, so stuck services are detected through the last error " +
+			"reported for each endpoint the bucket uses.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to fetch platform services status for.",
+				Required:    true,
+			},
+			"replication_enabled": schema.BoolAttribute{
+				Description: "Whether a CloudMirror replication configuration exists for the bucket.",
+				Computed:    true,
+			},
+			"replication_destinations": schema.ListAttribute{
+				Description: "The endpoint URNs that objects are replicated to.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"notification_enabled": schema.BoolAttribute{
+				Description: "Whether an event notification configuration exists for the bucket.",
+				Computed:    true,
+			},
+			"notification_destinations": schema.ListAttribute{
+				Description: "The endpoint URNs that event notifications are sent to.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"endpoints": schema.ListNestedAttribute{
+				Description: "The platform services endpoints referenced by the bucket configuration.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"urn": schema.StringAttribute{
+							Description: "The URN of the endpoint.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the endpoint.",
+							Computed:    true,
+						},
+						"uri": schema.StringAttribute{
+							Description: "The URI of the endpoint.",
+							Computed:    true,
+						},
+						"last_error": schema.StringAttribute{
+							Description: "The most recent error reported for the endpoint. Null when no error has been reported.",
+							Computed:    true,
+						},
+						"last_error_time": schema.StringAttribute{
+							Description: "The time of the most recent error reported for the endpoint.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"has_errors": schema.BoolAttribute{
+				Description: "Whether any endpoint used by the bucket has reported an error, or the bucket references an endpoint that does not exist.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *S3BucketPlatformServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *S3BucketPlatformServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state S3BucketPlatformServicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	services, err := d.client.GetS3BucketPlatformServices(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket Platform Services for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	endpoints, err := d.client.GetPlatformServiceEndpoints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Platform Services Endpoints",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map API response data to the Terraform state model
+	state.ReplicationEnabled = types.BoolValue(services.ReplicationEnabled)
+	state.NotificationEnabled = types.BoolValue(services.NotificationEnabled)
+	state.ReplicationDestinations = []types.String{}
+	for _, urn := range services.ReplicationDestinations {
+		state.ReplicationDestinations = append(state.ReplicationDestinations, types.StringValue(urn))
+	}
+	state.NotificationDestinations = []types.String{}
+	for _, urn := range services.NotificationDestinations {
+		state.NotificationDestinations = append(state.NotificationDestinations, types.StringValue(urn))
+	}
+
+	// Collect the endpoints referenced by the bucket, in order of first use
+	var referenced []string
+	for _, urn := range slices.Concat(services.ReplicationDestinations, services.NotificationDestinations) {
+		if !slices.Contains(referenced, urn) {
+			referenced = append(referenced, urn)
+		}
+	}
+
+	hasErrors := false
+	state.Endpoints = []PlatformServiceEndpointModel{}
+	for _, urn := range referenced {
+		idx := slices.IndexFunc(endpoints, func(e utils.PlatformServiceEndpointData) bool {
+			return e.EndpointURN == urn
+		})
+		if idx < 0 {
+			// The bucket references an endpoint that has been removed, so the service cannot deliver
+			hasErrors = true
+			continue
+		}
+
+		endpoint := endpoints[idx]
+		endpointModel := PlatformServiceEndpointModel{
+			URN:           types.StringValue(endpoint.EndpointURN),
+			DisplayName:   types.StringValue(endpoint.DisplayName),
+			URI:           types.StringValue(endpoint.EndpointURI),
+			LastError:     types.StringNull(),
+			LastErrorTime: types.StringNull(),
+		}
+		if endpoint.Error != nil && endpoint.Error.Text != "" {
+			hasErrors = true
+			endpointModel.LastError = types.StringValue(endpoint.Error.Text)
+			endpointModel.LastErrorTime = types.StringValue(endpoint.Error.Time)
+		}
+		state.Endpoints = append(state.Endpoints, endpointModel)
+	}
+	state.HasErrors = types.BoolValue(hasErrors)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
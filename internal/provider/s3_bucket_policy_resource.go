@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3BucketPolicyResource{}
+	_ resource.ResourceWithConfigure   = &S3BucketPolicyResource{}
+	_ resource.ResourceWithImportState = &S3BucketPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &S3BucketPolicyResource{}
+)
+
+func NewS3BucketPolicyResource() resource.Resource {
+	return &S3BucketPolicyResource{}
+}
+
+// S3BucketPolicyResource defines the resource implementation.
+type S3BucketPolicyResource struct {
+	client *utils.Client
+}
+
+// S3BucketPolicyResourceModel describes the resource data model.
+type S3BucketPolicyResourceModel struct {
+	BucketName types.String  `tfsdk:"bucket_name"`
+	Policy     S3PolicyValue `tfsdk:"policy"`
+	ID         types.String  `tfsdk:"id"`
+}
+
+func (r *S3BucketPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket_policy"
+}
+
+func (r *S3BucketPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the bucket policy of a StorageGrid S3 bucket, which grants or denies access to the bucket and its objects.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket to attach the policy to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description: "The bucket policy, provided as a JSON string with Principal, Effect, Action, Resource and optional Condition elements. " +
+					"Use the `file()` or `jsonencode()` function to build it. " +
+					"Policies that only differ in formatting, statement order or single values written as lists are equal, and the state keeps the policy as written.",
+				Required:   true,
+				CustomType: S3PolicyType{},
+				PlanModifiers: []planmodifier.String{
+					summarizeS3PolicyChanges(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the bucket policy (same as bucket_name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *S3BucketPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan warns about actions of the policy that StorageGrid does not implement when
+// the policy is new or changed.
+func (r *S3BucketPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.SkipS3ActionValidation {
+		return
+	}
+
+	var policy, statePolicy S3PolicyValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policy"), &policy)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policy"), &statePolicy)...)
+	}
+	if resp.Diagnostics.HasError() || policy.IsNull() || policy.IsUnknown() || policy.Equal(statePolicy) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkS3PolicyActions(path.Root("policy"), policy.ValueString())...)
+}
+
+func (r *S3BucketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.PutS3BucketPolicy(ctx, bucketName, plan.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Bucket Policy for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set the ID (same as bucket name)
+	plan.ID = types.StringValue(bucketName)
+
+	// Save the plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3BucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+	policy, err := r.client.GetS3BucketPolicy(ctx, bucketName)
+	if err != nil {
+		// The policy or the bucket was deleted outside of Terraform
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket Policy for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// StorageGrid may return the policy reformatted, semantic equality keeps the document
+	// as written unless the policy actually changed
+	state.Policy = NewS3PolicyValue(policy)
+	state.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3BucketPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3BucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	err := r.client.PutS3BucketPolicy(ctx, bucketName, plan.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Bucket Policy for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the updated plan to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3BucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3BucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	err := r.client.DeleteS3BucketPolicy(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete S3 Bucket Policy for %s", bucketName),
+			err.Error(),
+		)
+		return
+	}
+
+	// State is automatically cleared on successful delete
+}
+
+func (r *S3BucketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the bucket name as the identifier
+	bucketName := req.ID
+
+	policy, err := r.client.GetS3BucketPolicy(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Bucket Policy for %s", bucketName),
+			fmt.Sprintf("Bucket does not exist or has no policy: %s", err.Error()),
+		)
+		return
+	}
+
+	state := S3BucketPolicyResourceModel{
+		BucketName: types.StringValue(bucketName),
+		Policy:     NewS3PolicyValue(policy),
+		ID:         types.StringValue(bucketName),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	// Set the ID attribute explicitly for import
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
+}
@@ -6,26 +6,54 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &S3BucketResource{}
-	_ resource.ResourceWithConfigure   = &S3BucketResource{}
-	_ resource.ResourceWithImportState = &S3BucketResource{}
+	_ resource.Resource                   = &S3BucketResource{}
+	_ resource.ResourceWithConfigure      = &S3BucketResource{}
+	_ resource.ResourceWithImportState    = &S3BucketResource{}
+	_ resource.ResourceWithModifyPlan     = &S3BucketResource{}
+	_ resource.ResourceWithValidateConfig = &S3BucketResource{}
 )
 
+// defaultBucketRegion is the region StorageGrid reports for buckets created without one.
+const defaultBucketRegion = "us-east-1"
+
+// defaultDeleteObjectsTimeout is how long a destroy waits by default for StorageGrid to
+// finish deleting the objects of a bucket in the background.
+const defaultDeleteObjectsTimeout = "30m"
+
+// defaultBucketDeleteTimeout bounds bucket deletes without a delete timeout. It is longer
+// than defaultOperationTimeout to cover the wait for the background deletion of objects,
+// and is documented in the delete timeout of the timeouts block.
+const defaultBucketDeleteTimeout = time.Hour
+
 func NewS3BucketResource() resource.Resource {
 	return &S3BucketResource{}
 }
@@ -37,10 +65,51 @@ type S3BucketResource struct {
 
 // S3BucketResourceModel describes the resource data model.
 type S3BucketResourceModel struct {
-	BucketName        types.String `tfsdk:"bucket_name"`
-	Region            types.String `tfsdk:"region"`
-	ObjectLockEnabled types.Bool   `tfsdk:"object_lock_enabled"`
-	ID                types.String `tfsdk:"id"`
+	BucketName            types.String  `tfsdk:"bucket_name"`
+	BucketNamePrefix      types.String  `tfsdk:"bucket_name_prefix"`
+	Region                types.String  `tfsdk:"region"`
+	ObjectLockEnabled     types.Bool    `tfsdk:"object_lock_enabled"`
+	QuotaWarningThreshold types.Float64 `tfsdk:"quota_warning_threshold"`
+	EnforceQuotaHeadroom  types.Bool    `tfsdk:"enforce_quota_headroom"`
+	RequireEmpty          types.Bool    `tfsdk:"require_empty"`
+	DeletionProtection    types.Bool    `tfsdk:"deletion_protection"`
+	DeleteObjectsTimeout  types.String  `tfsdk:"delete_objects_timeout"`
+	Tags                  types.Map     `tfsdk:"tags"`
+	ID                    types.String  `tfsdk:"id"`
+	CreationTime          types.String  `tfsdk:"creation_time"`
+	Compliance            types.Object  `tfsdk:"compliance"`
+	S3ObjectLock          types.Object  `tfsdk:"s3_object_lock"`
+
+	ObjectLockDefaultRetention *ObjectLockDefaultRetentionModel `tfsdk:"object_lock_default_retention"`
+	Timeouts                   timeouts.Value                   `tfsdk:"timeouts"`
+}
+
+// ObjectLockDefaultRetentionModel describes the object_lock_default_retention block.
+type ObjectLockDefaultRetentionModel struct {
+	Mode  types.String `tfsdk:"mode"`
+	Days  types.Int64  `tfsdk:"days"`
+	Years types.Int64  `tfsdk:"years"`
+}
+
+// bucketComplianceAttributeTypes are the attribute types of the computed compliance attribute.
+var bucketComplianceAttributeTypes = map[string]attr.Type{
+	"auto_delete":              types.BoolType,
+	"legal_hold":               types.BoolType,
+	"retention_period_minutes": types.Int64Type,
+}
+
+// bucketDefaultRetentionAttributeTypes are the attribute types of
+// s3_object_lock.default_retention_setting.
+var bucketDefaultRetentionAttributeTypes = map[string]attr.Type{
+	"mode":  types.StringType,
+	"days":  types.Int64Type,
+	"years": types.Int64Type,
+}
+
+// bucketObjectLockAttributeTypes are the attribute types of the computed s3_object_lock attribute.
+var bucketObjectLockAttributeTypes = map[string]attr.Type{
+	"enabled":                   types.BoolType,
+	"default_retention_setting": types.ObjectType{AttrTypes: bucketDefaultRetentionAttributeTypes},
 }
 
 func (r *S3BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,23 +121,39 @@ func (r *S3BucketResource) Schema(ctx context.Context, req resource.SchemaReques
 		Description: "Manages a StorageGrid S3 bucket.",
 		Attributes: map[string]schema.Attribute{
 			"bucket_name": schema.StringAttribute{
-				Description: "The name of the S3 bucket.",
-				Required:    true,
+				Description: "The name of the S3 bucket. It must be 3 to 63 characters of lowercase letters, numbers, hyphens and periods, " +
+					"start and end with a letter or number, and not be formatted as an IP address. Exactly one of bucket_name and bucket_name_prefix must be set.",
+				Optional: true,
+				Computed: true,
+				Validators: append(bucketNameValidators(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("bucket_name_prefix")),
+				),
+				PlanModifiers: []planmodifier.String{
+					keepGeneratedBucketName(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket_name_prefix": schema.StringAttribute{
+				Description: fmt.Sprintf("Creates a bucket whose name starts with this prefix, followed by a unique suffix of %d lowercase letters and numbers. "+
+					"The generated name is exported as bucket_name. Conflicts with bucket_name.", bucketNameSuffixLength),
+				Optional:   true,
+				Validators: bucketNamePrefixValidators(),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"region": schema.StringAttribute{
-				Description: "The region where the bucket should be created.",
+				Description: "The region where the bucket should be created, which must be a region of the grid and is checked at plan time. Defaults to the provider default_region, or to the default region of StorageGrid when that is not set either.",
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString("us-east-1"),
 				PlanModifiers: []planmodifier.String{
+					// A changed default_region only applies to new buckets
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"object_lock_enabled": schema.BoolAttribute{
-				Description: "Whether S3 Object Lock is enabled for this bucket. Defaults to false. When enabled, uses governance mode with 1 day retention as default.",
+				Description: "Whether S3 Object Lock is enabled for this bucket. Defaults to false. When enabled, objects get the object_lock_default_retention, or governance mode with 1 day retention when that is not set.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
@@ -76,10 +161,156 @@ func (r *S3BucketResource) Schema(ctx context.Context, req resource.SchemaReques
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"quota_warning_threshold": schema.Float64Attribute{
+				Description: "Percentage (0-100) of the tenant quota in use above which a warning is emitted when the bucket is created. The check is skipped when unset or when the tenant has no quota.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"enforce_quota_headroom": schema.BoolAttribute{
+				Description: "Whether exceeding `quota_warning_threshold` fails bucket creation instead of emitting a warning. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("quota_warning_threshold")),
+				},
+			},
+			"require_empty": schema.BoolAttribute{
+				Description: "Whether deleting the bucket first checks the tenant usage data and fails with the number of objects still stored in it. " +
+					"The setting is read from state, so it must be applied before the destroy. Usage data is calculated periodically by StorageGrid, " +
+					"so objects written shortly before the destroy may not be counted yet. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether deleting the bucket fails, including replacements, as a guardrail on top of the prevent_destroy lifecycle setting. " +
+					"The setting is read from state, so it must be disabled and applied before the bucket can be destroyed. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"delete_objects_timeout": schema.StringAttribute{
+				Description: "How long deleting the bucket waits for StorageGrid to finish deleting its objects in the background, as a Go duration string such as \"30m\" or \"2h\". " +
+					"StorageGrid rejects the deletion of a bucket while its objects are being deleted. The setting is read from state, so it must be applied before the destroy. Defaults to " + defaultDeleteObjectsTimeout + ". " +
+					"The delete timeout of the timeouts block bounds the whole deletion, including this wait, so the wait ends when the earlier of the two timeouts expires. Raise both to wait longer than an hour.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultDeleteObjectsTimeout),
+			},
+			"tags": schema.MapAttribute{
+				Description: "The tags of the bucket, for example for chargeback. They replace all existing tags of the bucket, and tags changed outside of Terraform are reported as drift. " +
+					"When unset, the tags of the bucket are not managed; set it to an empty map to remove all tags. Do not use it together with storagegrid_s3_bucket_tagging for the same bucket.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  bucketTagsValidators(),
+			},
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for the bucket (same as name).",
 				Computed:    true,
 			},
+			"creation_time": schema.StringAttribute{
+				Description: "The time when the bucket was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compliance": schema.SingleNestedAttribute{
+				Description: "The legacy compliance settings of the bucket, or null for buckets without legacy compliance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"auto_delete": schema.BoolAttribute{
+						Description: "Whether objects are deleted automatically when their retention period expires.",
+						Computed:    true,
+					},
+					"legal_hold": schema.BoolAttribute{
+						Description: "Whether the bucket is under legal hold.",
+						Computed:    true,
+					},
+					"retention_period_minutes": schema.Int64Attribute{
+						Description: "The retention period of the objects in minutes.",
+						Computed:    true,
+					},
+				},
+			},
+			"s3_object_lock": schema.SingleNestedAttribute{
+				Description: "The current S3 object lock configuration of the bucket, including changes made with storagegrid_s3_bucket_object_lock_configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Indicates if S3 object lock is enabled.",
+						Computed:    true,
+					},
+					"default_retention_setting": schema.SingleNestedAttribute{
+						Description: "Default retention settings for object lock.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Description: "The retention mode (compliance or governance).",
+								Computed:    true,
+							},
+							"days": schema.Int64Attribute{
+								Description: "Retention period in days.",
+								Computed:    true,
+							},
+							"years": schema.Int64Attribute{
+								Description: "Retention period in years.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				DeleteDescription: "How long deleting the bucket may take as a whole, as a duration string such as \"30m\" or \"2h\". Defaults to 1h. " +
+					"It includes waiting for StorageGrid to delete the objects of the bucket, which delete_objects_timeout also bounds, so the wait ends when the earlier of the two timeouts expires. " +
+					"Setting a timeout for a Delete operation is only applicable if changes are saved into state before the destroy operation occurs.",
+			}),
+			"object_lock_default_retention": schema.SingleNestedBlock{
+				Description: "Default retention of the objects of the bucket, set when the bucket is created with object_lock_enabled. " +
+					"Changing it does not affect an existing bucket, use storagegrid_s3_bucket_object_lock_configuration to manage the retention afterwards.",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Description: "The retention mode (compliance or governance).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("compliance", "governance"),
+						},
+					},
+					"days": schema.Int64Attribute{
+						Description: "Retention period in days. Conflicts with years.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("years")),
+						},
+					},
+					"years": schema.Int64Attribute{
+						Description: "Retention period in years. Conflicts with days.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("mode")),
+				},
+			},
 		},
 	}
 }
@@ -89,19 +320,124 @@ func (r *S3BucketResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+}
+
+// ValidateConfig checks that delete_objects_timeout is a duration, and that
+// object_lock_default_retention is only set for buckets with object lock enabled, with one
+// retention period.
+func (r *S3BucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config S3BucketResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if timeout := config.DeleteObjectsTimeout; !timeout.IsNull() && !timeout.IsUnknown() {
+		if duration, err := time.ParseDuration(timeout.ValueString()); err != nil || duration <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("delete_objects_timeout"),
+				"Invalid Delete Objects Timeout",
+				fmt.Sprintf("The timeout must be a positive duration such as \"30m\" or \"2h\", got %q.", timeout.ValueString()),
+			)
+		}
+	}
+
+	if config.ObjectLockDefaultRetention == nil {
+		return
+	}
+
+	if !config.ObjectLockEnabled.IsUnknown() && !config.ObjectLockEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_lock_default_retention"),
+			"Object Lock Not Enabled",
+			"object_lock_default_retention only applies to buckets created with object_lock_enabled set to true.",
+		)
+	}
+
+	retention := config.ObjectLockDefaultRetention
+	if retention.Days.IsNull() && retention.Years.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_lock_default_retention"),
+			"Missing Retention Period",
+			"object_lock_default_retention must set days or years.",
+		)
+	}
+}
+
+// ModifyPlan plans the provider default_region for new buckets that do not set a region,
+// and checks that the region of new buckets is a region of the grid.
+func (r *S3BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var region types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if region.IsUnknown() && req.State.Raw.IsNull() && r.client.DefaultRegion != "" {
+		region = types.StringValue(r.client.DefaultRegion)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("region"), region)...)
+	}
+	if region.IsNull() || region.IsUnknown() {
+		return
+	}
+
+	// The region of an existing bucket was accepted when it was created
+	if !req.State.Raw.IsNull() {
+		var current types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("region"), &current)...)
+		if resp.Diagnostics.HasError() || current.Equal(region) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.checkRegion(ctx, region.ValueString())...)
+}
+
+// checkRegion returns an error when region is not one of the regions of the grid. The
+// check is skipped when the regions cannot be read, leaving the final word to the API.
+func (r *S3BucketResource) checkRegion(ctx context.Context, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	regions, err := r.client.GetRegions(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to read the regions of the grid, skipping the region check", map[string]any{
+			"region": region,
+			"error":  err.Error(),
+		})
+		return diags
+	}
+
+	if len(regions) > 0 && !slices.Contains(regions, region) {
+		diags.AddAttributeError(
+			path.Root("region"),
+			"Invalid S3 Bucket Region",
+			fmt.Sprintf("Region %q is not a region of the grid. The tenant can create buckets in: %s.", region, strings.Join(regions, ", ")),
+		)
+	}
+
+	return diags
 }
 
 func (r *S3BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -109,12 +445,43 @@ func (r *S3BucketResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Create, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Buckets named from a prefix get their name now
+	if plan.BucketName.IsUnknown() {
+		name, err := uniqueBucketName(plan.BucketNamePrefix.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Generate S3 Bucket Name", err.Error())
+			return
+		}
+		plan.BucketName = types.StringValue(name)
+	}
+
 	// Create the bucket
 	bucketName := plan.BucketName.ValueString()
 	region := plan.Region.ValueString()
 	objectLockEnabled := plan.ObjectLockEnabled.ValueBool()
 
-	err := r.client.CreateS3Bucket(bucketName, region, objectLockEnabled)
+	var defaultRetention *utils.S3BucketCreateRetentionSetting
+	if retention := plan.ObjectLockDefaultRetention; retention != nil {
+		defaultRetention = &utils.S3BucketCreateRetentionSetting{
+			Mode:  retention.Mode.ValueString(),
+			Days:  int(retention.Days.ValueInt64()),
+			Years: int(retention.Years.ValueInt64()),
+		}
+	}
+
+	resp.Diagnostics.Append(r.checkQuotaHeadroom(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateS3Bucket(ctx, bucketName, region, objectLockEnabled, defaultRetention)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Create S3 Bucket %s", bucketName),
@@ -123,9 +490,26 @@ func (r *S3BucketResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Buckets created without a region get the default region of StorageGrid. The computed
+	// attributes are null when the bucket cannot be read back, until the next refresh
+	bucket, err := r.client.GetS3Bucket(ctx, bucketName)
+	if err != nil {
+		bucket = &utils.S3BucketData{Name: bucketName}
+	}
+	if plan.Region.IsUnknown() {
+		plan.Region = types.StringValue(defaultBucketRegion)
+		if bucket.Region != "" {
+			plan.Region = types.StringValue(bucket.Region)
+		}
+	}
+	resp.Diagnostics.Append(setComputedBucketAttributes(&plan, bucket)...)
+
 	// Set the ID (same as name for S3 buckets)
 	plan.ID = types.StringValue(bucketName)
 
+	// The bucket exists from here on, so it is saved to state even when tagging fails
+	resp.Diagnostics.Append(r.applyTags(ctx, bucketName, plan.Tags)...)
+
 	// Save the plan to state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -139,14 +523,22 @@ func (r *S3BucketResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	bucketName := state.BucketName.ValueString()
-	bucket, err := r.client.GetS3Bucket(bucketName)
+	bucket, err := r.client.GetS3Bucket(ctx, bucketName)
 	if err != nil {
-		// If bucket is not found, remove from state
-		resp.Diagnostics.AddWarning(
-			fmt.Sprintf("S3 Bucket %s not found", bucketName),
-			"The bucket may have been deleted outside of Terraform. Removing from state.",
+		// Only a bucket missing from the bucket list is removed from state, other failures
+		// such as timeouts must not make the next apply recreate the bucket
+		if utils.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("S3 Bucket %s not found", bucketName),
+				"The bucket may have been deleted outside of Terraform. Removing from state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Bucket %s", bucketName),
+			err.Error(),
 		)
-		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -157,35 +549,71 @@ func (r *S3BucketResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if bucket.Region != "" {
 		state.Region = types.StringValue(bucket.Region)
 	} else {
-		state.Region = types.StringValue("us-east-1")
+		state.Region = types.StringValue(defaultBucketRegion)
 	}
 
-	// Get object lock status directly from the object lock API
-	objectLock, err := r.client.GetS3BucketObjectLock(bucketName)
-	if err != nil {
-		// If object lock API fails, assume object lock is disabled
-		state.ObjectLockEnabled = types.BoolValue(false)
-		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-		return
+	state.ObjectLockEnabled = types.BoolValue(r.objectLockEnabled(ctx, bucket))
+	resp.Diagnostics.Append(setComputedBucketAttributes(&state, bucket)...)
+
+	// Tags are only refreshed when they are managed
+	if !state.Tags.IsNull() {
+		tags, err := r.client.GetS3BucketTagging(ctx, bucketName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read Tags of S3 Bucket %s", bucketName),
+				err.Error(),
+			)
+			return
+		}
+		var diags diag.Diagnostics
+		state.Tags, diags = bucketTagsValue(ctx, tags)
+		resp.Diagnostics.Append(diags...)
 	}
 
-	state.ObjectLockEnabled = types.BoolValue(objectLock.Enabled)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *S3BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Since StorageGrid doesn't support PUT operations for bucket updates,
-	// all attribute changes require replacement (destroy/create cycle).
-	// This method should never be called due to RequiresReplace plan modifiers.
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"StorageGrid S3 buckets do not support in-place updates. All attribute changes "+
-			"(name, region, object_lock_enabled) require replacement and should trigger a "+
-			"destroy/create cycle instead of an update.",
-	)
+	// all bucket attribute changes require replacement (destroy/create cycle).
+	// Only the tags and provider-side settings such as the quota check can
+	// change in place.
+	var plan, state S3BucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Update, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Tags.Equal(state.Tags) {
+		resp.Diagnostics.Append(r.applyTags(ctx, plan.BucketName.ValueString(), plan.Tags)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *S3BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state S3BucketResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -193,9 +621,55 @@ func (r *S3BucketResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel, diags := operationContext(ctx, state.Timeouts.Delete, defaultBucketDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.BucketName.ValueString()
 
-	err := r.client.DeleteS3Bucket(bucketName)
+	resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "bucket", bucketName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RequireEmpty.ValueBool() {
+		resp.Diagnostics.Append(r.checkBucketEmpty(ctx, bucketName)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Buckets cannot be deleted while StorageGrid deletes their objects in the background.
+	// A bucket that no longer exists counts as deleted
+	timeout, err := time.ParseDuration(state.DeleteObjectsTimeout.ValueString())
+	if err != nil {
+		timeout, _ = time.ParseDuration(defaultDeleteObjectsTimeout)
+	}
+	err = r.client.WaitForS3BucketObjectDeletion(ctx, bucketName, timeout)
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete S3 Bucket %s", bucketName),
+			fmt.Sprintf("Could not wait for the objects of the bucket to be deleted: %s", err.Error()),
+		)
+		return
+	}
+
+	err = r.client.DeleteS3Bucket(ctx, bucketName)
+	if utils.IsBucketNotEmpty(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("S3 Bucket %s Is Not Empty", bucketName),
+			"StorageGrid only deletes empty buckets. Remove the objects, including all object versions and delete markers "+
+				"of a versioned bucket, before destroying the bucket. Objects under object lock retention can only be removed once their retention expires.\n\n"+
+				err.Error(),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Delete S3 Bucket %s", bucketName),
@@ -212,7 +686,7 @@ func (r *S3BucketResource) ImportState(ctx context.Context, req resource.ImportS
 	bucketName := req.ID
 
 	// Validate that the bucket exists by trying to fetch it
-	bucket, err := r.client.GetS3Bucket(bucketName)
+	bucket, err := r.client.GetS3Bucket(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Import S3 Bucket %s", bucketName),
@@ -223,28 +697,26 @@ func (r *S3BucketResource) ImportState(ctx context.Context, req resource.ImportS
 
 	// Set the imported bucket data in state
 	state := S3BucketResourceModel{
-		BucketName: types.StringValue(bucket.Name),
-		ID:         types.StringValue(bucket.Name),
+		BucketName:            types.StringValue(bucket.Name),
+		ID:                    types.StringValue(bucket.Name),
+		QuotaWarningThreshold: types.Float64Null(),
+		EnforceQuotaHeadroom:  types.BoolValue(false),
+		RequireEmpty:          types.BoolValue(false),
+		DeletionProtection:    types.BoolValue(false),
+		DeleteObjectsTimeout:  types.StringValue(defaultDeleteObjectsTimeout),
+		Tags:                  types.MapNull(types.StringType),
+		Timeouts:              nullTimeouts(),
 	}
 
 	// Set region with fallback to default
 	if bucket.Region != "" {
 		state.Region = types.StringValue(bucket.Region)
 	} else {
-		state.Region = types.StringValue("us-east-1")
+		state.Region = types.StringValue(defaultBucketRegion)
 	}
 
-	// Get object lock status directly from the object lock API
-	objectLock, err := r.client.GetS3BucketObjectLock(bucketName)
-	if err != nil {
-		// If object lock API fails, assume object lock is disabled
-		state.ObjectLockEnabled = types.BoolValue(false)
-		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-		resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
-		return
-	}
-
-	state.ObjectLockEnabled = types.BoolValue(objectLock.Enabled)
+	state.ObjectLockEnabled = types.BoolValue(r.objectLockEnabled(ctx, bucket))
+	resp.Diagnostics.Append(setComputedBucketAttributes(&state, bucket)...)
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -252,3 +724,162 @@ func (r *S3BucketResource) ImportState(ctx context.Context, req resource.ImportS
 	// Set the ID attribute explicitly for import
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket_name"), req, resp)
 }
+
+// applyTags replaces the tags of the bucket. Unset tags are not managed and leave the
+// tags of the bucket as they are.
+func (r *S3BucketResource) applyTags(ctx context.Context, bucketName string, value types.Map) diag.Diagnostics {
+	if value.IsNull() {
+		return nil
+	}
+
+	tags, diags := bucketTags(ctx, value)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.PutS3BucketTagging(ctx, bucketName, tags); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to Tag S3 Bucket %s", bucketName),
+			err.Error(),
+		)
+	}
+	return diags
+}
+
+// setComputedBucketAttributes sets creation_time, compliance and s3_object_lock of model
+// from the bucket listing. Settings missing from the listing are null.
+func setComputedBucketAttributes(model *S3BucketResourceModel, bucket *utils.S3BucketData) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.CreationTime = types.StringNull()
+	if bucket.CreationTime != "" {
+		model.CreationTime = types.StringValue(bucket.CreationTime)
+	}
+
+	model.Compliance = types.ObjectNull(bucketComplianceAttributeTypes)
+	if compliance := bucket.Compliance; compliance != nil {
+		model.Compliance, d = types.ObjectValue(bucketComplianceAttributeTypes, map[string]attr.Value{
+			"auto_delete":              types.BoolValue(compliance.AutoDelete),
+			"legal_hold":               types.BoolValue(compliance.LegalHold),
+			"retention_period_minutes": types.Int64Value(compliance.RetentionPeriodMinutes),
+		})
+		diags.Append(d...)
+	}
+
+	model.S3ObjectLock = types.ObjectNull(bucketObjectLockAttributeTypes)
+	if objectLock := bucket.S3ObjectLock; objectLock != nil {
+		retention := types.ObjectNull(bucketDefaultRetentionAttributeTypes)
+		if setting := objectLock.DefaultRetentionSetting; setting != nil {
+			days, years := types.Int64Null(), types.Int64Null()
+			if setting.Days > 0 {
+				days = types.Int64Value(int64(setting.Days))
+			}
+			if setting.Years > 0 {
+				years = types.Int64Value(int64(setting.Years))
+			}
+			retention, d = types.ObjectValue(bucketDefaultRetentionAttributeTypes, map[string]attr.Value{
+				"mode":  types.StringValue(setting.Mode),
+				"days":  days,
+				"years": years,
+			})
+			diags.Append(d...)
+		}
+		model.S3ObjectLock, d = types.ObjectValue(bucketObjectLockAttributeTypes, map[string]attr.Value{
+			"enabled":                   types.BoolValue(objectLock.Enabled),
+			"default_retention_setting": retention,
+		})
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// objectLockEnabled reports whether object lock is enabled on the bucket. The object lock
+// API is queried first; when it fails, the setting from the bucket listing is used instead
+// of assuming false, which would plan a replacement of a locked bucket.
+func (r *S3BucketResource) objectLockEnabled(ctx context.Context, bucket *utils.S3BucketData) bool {
+	objectLock, err := r.client.GetS3BucketObjectLock(ctx, bucket.Name)
+	if err != nil {
+		return bucket.S3ObjectLock != nil && bucket.S3ObjectLock.Enabled
+	}
+	return objectLock.Enabled
+}
+
+// checkBucketEmpty returns an error with the object count when the tenant usage data
+// reports objects in the bucket. Buckets missing from the usage data have not been
+// counted yet and are treated as empty, leaving the final word to the delete API.
+func (r *S3BucketResource) checkBucketEmpty(ctx context.Context, bucketName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	usage, err := r.client.GetTenantUsage(ctx)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to Check Whether S3 Bucket %s Is Empty", bucketName),
+			err.Error(),
+		)
+		return diags
+	}
+
+	bucket, ok := usage.BucketUsage(bucketName)
+	if ok && bucket.ObjectCount > 0 {
+		diags.AddError(
+			fmt.Sprintf("S3 Bucket %s Is Not Empty", bucketName),
+			fmt.Sprintf("The bucket contains %d objects (%d bytes) according to the usage data calculated at %s. "+
+				"Remove the objects before destroying the bucket, or set require_empty to false.",
+				bucket.ObjectCount, bucket.DataBytes, usage.CalculationTime),
+		)
+	}
+
+	return diags
+}
+
+// checkQuotaHeadroom compares the tenant quota utilization against the configured
+// threshold and returns a warning, or an error when enforce_quota_headroom is set.
+func (r *S3BucketResource) checkQuotaHeadroom(ctx context.Context, plan S3BucketResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.QuotaWarningThreshold.IsNull() || plan.QuotaWarningThreshold.IsUnknown() {
+		return diags
+	}
+
+	bucketName := plan.BucketName.ValueString()
+	threshold := plan.QuotaWarningThreshold.ValueFloat64()
+	enforce := plan.EnforceQuotaHeadroom.ValueBool()
+
+	usage, err := r.client.GetTenantUsage(ctx)
+	if err != nil {
+		summary := fmt.Sprintf("Unable to Check Tenant Quota for S3 Bucket %s", bucketName)
+		if enforce {
+			diags.AddError(summary, err.Error())
+		} else {
+			diags.AddWarning(summary, err.Error())
+		}
+		return diags
+	}
+
+	utilization, ok := usage.QuotaUtilization()
+	if !ok {
+		tflog.Debug(ctx, "Tenant has no quota configured, skipping quota headroom check", map[string]any{
+			"bucket_name": bucketName,
+		})
+		return diags
+	}
+
+	if utilization < threshold {
+		return diags
+	}
+
+	summary := "Tenant Quota Utilization Above Threshold"
+	detail := fmt.Sprintf(
+		"The tenant is using %.1f%% of its quota (%d of %d bytes), which is at or above the configured "+
+			"quota_warning_threshold of %.1f%% for bucket %s.",
+		utilization, usage.DataBytes, *usage.QuotaObjectBytes, threshold, bucketName,
+	)
+	if enforce {
+		diags.AddError(summary, detail+" Bucket creation was aborted because enforce_quota_headroom is set.")
+	} else {
+		diags.AddWarning(summary, detail)
+	}
+
+	return diags
+}
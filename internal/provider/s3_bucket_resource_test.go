@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestS3BucketResourceReadKeepsStateOnTransientErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantRemoved bool
+		wantError   bool
+	}{
+		{
+			name:        "bucket deleted outside of Terraform",
+			status:      http.StatusOK,
+			body:        `{"status": "success", "data": [{"name": "other"}]}`,
+			wantRemoved: true,
+		},
+		{
+			name:      "management API failure",
+			status:    http.StatusBadRequest,
+			body:      `{"status": "error", "message": {"text": "request failed"}}`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			r := &S3BucketResource{client: &utils.Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+			}}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			stateType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			attributes := map[string]tftypes.Value{}
+			for name, attrType := range stateType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attrType, nil)
+			}
+			attributes["bucket_name"] = tftypes.NewValue(tftypes.String, "logs")
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(stateType, attributes)}
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if got := resp.State.Raw.IsNull(); got != tt.wantRemoved {
+				t.Errorf("state removed = %v, want %v", got, tt.wantRemoved)
+			}
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("error diagnostics = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSetComputedBucketAttributes(t *testing.T) {
+	var model S3BucketResourceModel
+	diags := setComputedBucketAttributes(&model, &utils.S3BucketData{
+		Name:         "logs",
+		CreationTime: "2026-01-01T00:00:00.000Z",
+		Compliance:   &utils.ComplianceConfig{AutoDelete: true, RetentionPeriodMinutes: 1440},
+		S3ObjectLock: &utils.S3ObjectLockConfig{
+			Enabled:                 true,
+			DefaultRetentionSetting: &utils.DefaultRetentionSetting{Mode: "governance", Years: 2},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := model.CreationTime.ValueString(); got != "2026-01-01T00:00:00.000Z" {
+		t.Errorf("creation_time = %q", got)
+	}
+	compliance := model.Compliance.Attributes()
+	if got := compliance["retention_period_minutes"].(types.Int64).ValueInt64(); got != 1440 {
+		t.Errorf("compliance.retention_period_minutes = %d, want 1440", got)
+	}
+	if got := compliance["auto_delete"].(types.Bool).ValueBool(); !got {
+		t.Error("compliance.auto_delete = false, want true")
+	}
+	retention := model.S3ObjectLock.Attributes()["default_retention_setting"].(types.Object).Attributes()
+	if got := retention["years"].(types.Int64).ValueInt64(); got != 2 {
+		t.Errorf("s3_object_lock.default_retention_setting.years = %d, want 2", got)
+	}
+	if !retention["days"].IsNull() {
+		t.Errorf("s3_object_lock.default_retention_setting.days = %s, want null", retention["days"])
+	}
+
+	diags = setComputedBucketAttributes(&model, &utils.S3BucketData{Name: "logs"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !model.CreationTime.IsNull() || !model.Compliance.IsNull() || !model.S3ObjectLock.IsNull() {
+		t.Errorf("computed attributes = %s, %s, %s, want null", model.CreationTime, model.Compliance, model.S3ObjectLock)
+	}
+}
+
+func TestS3BucketResourceCheckRegion(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		region  string
+		wantErr bool
+	}{
+		{name: "region of the grid", status: http.StatusOK, region: "eu-central-1"},
+		{name: "unknown region", status: http.StatusOK, region: "eu-centrl-1", wantErr: true},
+		{name: "regions not readable", status: http.StatusForbidden, region: "eu-centrl-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"status": "success", "data": ["us-east-1", "eu-central-1"]}`))
+			}))
+			defer server.Close()
+
+			r := &S3BucketResource{client: &utils.Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+			}}
+
+			if diags := r.checkRegion(context.Background(), tt.region); diags.HasError() != tt.wantErr {
+				t.Fatalf("checkRegion(%q) diagnostics = %v, want error %v", tt.region, diags, tt.wantErr)
+			}
+		})
+	}
+}
@@ -47,8 +47,9 @@ func (d *S3BucketVersioningDataSource) Schema(ctx context.Context, req datasourc
 				Required:    true,
 			},
 			"status": schema.StringAttribute{
-				Description: "The versioning status for the bucket. Possible values are 'Disabled', 'Enabled' or 'Suspended'.",
-				Computed:    true,
+				Description: "The versioning status for the bucket. Possible values are 'Enabled', 'Suspended' or 'Unversioned' (versioning has never been enabled). " +
+					"Earlier versions of the provider reported 'Unversioned' as 'Disabled'.",
+				Computed: true,
 			},
 		},
 	}
@@ -59,16 +60,16 @@ func (d *S3BucketVersioningDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *S3BucketVersioningDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -80,7 +81,7 @@ func (d *S3BucketVersioningDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	bucketName := state.BucketName.ValueString()
-	versioning, err := d.client.GetS3BucketVersioning(bucketName)
+	versioning, err := d.client.GetS3BucketVersioning(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket Versioning for %s", bucketName),
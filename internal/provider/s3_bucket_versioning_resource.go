@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &S3BucketVersioningResource{}
 	_ resource.ResourceWithConfigure   = &S3BucketVersioningResource{}
 	_ resource.ResourceWithImportState = &S3BucketVersioningResource{}
+	_ resource.ResourceWithModifyPlan  = &S3BucketVersioningResource{}
 )
 
 func NewS3BucketVersioningResource() resource.Resource {
@@ -59,12 +60,13 @@ func (r *S3BucketVersioningResource) Schema(ctx context.Context, req resource.Sc
 				},
 			},
 			"status": schema.StringAttribute{
-				Description: "The versioning status for the bucket. Valid values to set are 'Enabled' or 'Suspended'. When reading, may also return 'Disabled' for buckets where versioning has never been enabled. Defaults to 'Enabled'.",
-				Optional:    true,
-				Computed:    true,
-				Default:     stringdefault.StaticString("Enabled"),
+				Description: "The versioning status for the bucket. Valid values are 'Enabled', 'Suspended' or 'Unversioned'. 'Unversioned' is only accepted for buckets where versioning has never been enabled, and leaves the bucket unchanged. " +
+					"'Disabled' is a deprecated alias of 'Unversioned' and will be removed in a future release. Defaults to 'Enabled'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Enabled"),
 				Validators: []validator.String{
-					stringvalidator.OneOf("Enabled", "Suspended"),
+					stringvalidator.OneOf("Enabled", "Suspended", "Unversioned", deprecatedUnversionedStatus),
 				},
 			},
 			"id": schema.StringAttribute{
@@ -83,16 +85,16 @@ func (r *S3BucketVersioningResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 // statusToAPIBools converts status string to API boolean fields.
@@ -116,12 +118,107 @@ func apiBoolsToStatus(enabled bool, suspended bool) string {
 	if suspended {
 		return "Suspended"
 	}
-	// Default to Disabled if both are false, meaning bucket hasn't had versioning enabled yet
-	// Once a bucket has versioning enabled it can only toggle between Enabled and Suspended
-	return "Disabled"
+	// Both flags are false when the bucket hasn't had versioning enabled yet, which S3
+	// tooling calls "Unversioned". Once a bucket has versioning enabled it can only
+	// toggle between Enabled and Suspended
+	return "Unversioned"
+}
+
+// deprecatedUnversionedStatus is the previous name of the Unversioned status, still
+// accepted in configurations and kept in state where it was used.
+const deprecatedUnversionedStatus = "Disabled"
+
+// isUnversionedStatus reports whether status means that versioning has never been
+// enabled, under its current or its deprecated name.
+func isUnversionedStatus(status string) bool {
+	return status == "Unversioned" || status == deprecatedUnversionedStatus
+}
+
+// keepDeprecatedStatus returns the status read from the bucket, or the deprecated name
+// of Unversioned when the state already used it, so that it does not drift.
+func keepDeprecatedStatus(status, stateStatus string) string {
+	if status == "Unversioned" && stateStatus == deprecatedUnversionedStatus {
+		return deprecatedUnversionedStatus
+	}
+	return status
+}
+
+// validateVersioningTransition checks that the bucket may change from the versioning
+// status current to planned. Unversioned may be changed to Enabled or Suspended, and
+// Enabled and Suspended may be changed into each other, but neither can return to
+// Unversioned.
+func validateVersioningTransition(current, planned string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isUnversionedStatus(planned) && !isUnversionedStatus(current) {
+		diags.AddAttributeError(
+			path.Root("status"),
+			"Invalid Versioning Status Transition",
+			fmt.Sprintf("Bucket versioning is %s and cannot return to Unversioned once versioning has been enabled. Set status to Suspended to stop creating new object versions.", current),
+		)
+	}
+	return diags
+}
+
+// ModifyPlan warns about the deprecated Disabled status and reports a return to
+// Unversioned at plan time. On create the current status is not known yet and is checked
+// when the status is applied.
+func (r *S3BucketVersioningResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan S3BucketVersioningResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Status.ValueString() == deprecatedUnversionedStatus {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("status"),
+			"Deprecated Versioning Status",
+			"The 'Disabled' status has been renamed to 'Unversioned' and will be removed in a future release. Set status to 'Unversioned' instead.",
+		)
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state S3BucketVersioningResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Status.IsUnknown() || state.Status.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateVersioningTransition(state.Status.ValueString(), plan.Status.ValueString())...)
+}
+
+// applyVersioningStatus sets the versioning status of the bucket. A bucket cannot return to
+// Unversioned once versioning has been enabled, so that status is only accepted when the
+// bucket is still unversioned, and nothing is changed.
+func (r *S3BucketVersioningResource) applyVersioningStatus(ctx context.Context, bucketName string, status string) error {
+	if isUnversionedStatus(status) {
+		versioning, err := r.client.GetS3BucketVersioning(ctx, bucketName)
+		if err != nil {
+			return err
+		}
+		if current := apiBoolsToStatus(versioning.VersioningEnabled, versioning.VersioningSuspended); current != "Unversioned" {
+			return fmt.Errorf("bucket versioning is %s and cannot return to Unversioned; set status to Suspended instead", current)
+		}
+		return nil
+	}
+
+	// Convert status to API boolean fields
+	versioningEnabled, versioningSuspended := statusToAPIBools(status)
+
+	return r.client.UpdateS3BucketVersioning(ctx, bucketName, versioningEnabled, versioningSuspended)
 }
 
 func (r *S3BucketVersioningResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketVersioningResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -132,13 +229,10 @@ func (r *S3BucketVersioningResource) Create(ctx context.Context, req resource.Cr
 	bucketName := plan.BucketName.ValueString()
 	status := plan.Status.ValueString()
 
-	// Convert status to API boolean fields
-	versioningEnabled, versioningSuspended := statusToAPIBools(status)
-
-	err := r.client.UpdateS3BucketVersioning(bucketName, versioningEnabled, versioningSuspended)
+	err := r.applyVersioningStatus(ctx, bucketName, status)
 	if err != nil {
 		// Check if this is a conflict due to object lock being enabled
-		if strings.Contains(err.Error(), "Object Lock configuration is present") {
+		if utils.IsObjectLockVersioningConflict(err) {
 			resp.Diagnostics.AddError(
 				"Cannot Modify Versioning on Object Lock Enabled Bucket",
 				fmt.Sprintf("Bucket %s has object lock enabled. When object lock is enabled, versioning cannot be modified. Object lock requires versioning to be enabled and this cannot be changed.", bucketName),
@@ -168,7 +262,7 @@ func (r *S3BucketVersioningResource) Read(ctx context.Context, req resource.Read
 	}
 
 	bucketName := state.BucketName.ValueString()
-	versioning, err := r.client.GetS3BucketVersioning(bucketName)
+	versioning, err := r.client.GetS3BucketVersioning(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read S3 Bucket Versioning Configuration for %s", bucketName),
@@ -181,13 +275,18 @@ func (r *S3BucketVersioningResource) Read(ctx context.Context, req resource.Read
 	status := apiBoolsToStatus(versioning.VersioningEnabled, versioning.VersioningSuspended)
 
 	// Update state with current values
-	state.Status = types.StringValue(status)
+	state.Status = types.StringValue(keepDeprecatedStatus(status, state.Status.ValueString()))
 	state.ID = types.StringValue(bucketName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *S3BucketVersioningResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan S3BucketVersioningResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -198,13 +297,10 @@ func (r *S3BucketVersioningResource) Update(ctx context.Context, req resource.Up
 	bucketName := plan.BucketName.ValueString()
 	status := plan.Status.ValueString()
 
-	// Convert status to API boolean fields
-	versioningEnabled, versioningSuspended := statusToAPIBools(status)
-
-	err := r.client.UpdateS3BucketVersioning(bucketName, versioningEnabled, versioningSuspended)
+	err := r.applyVersioningStatus(ctx, bucketName, status)
 	if err != nil {
 		// Check if this is a conflict due to object lock being enabled
-		if strings.Contains(err.Error(), "Object Lock configuration is present") {
+		if utils.IsObjectLockVersioningConflict(err) {
 			resp.Diagnostics.AddError(
 				"Cannot Modify Versioning on Object Lock Enabled Bucket",
 				fmt.Sprintf("Bucket %s has object lock enabled. When object lock is enabled, versioning cannot be modified. Object lock requires versioning to be enabled and this cannot be changed.", bucketName),
@@ -223,6 +319,11 @@ func (r *S3BucketVersioningResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *S3BucketVersioningResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state S3BucketVersioningResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -232,11 +333,16 @@ func (r *S3BucketVersioningResource) Delete(ctx context.Context, req resource.De
 
 	bucketName := state.BucketName.ValueString()
 
+	// An unversioned bucket was never changed by this resource, so there is nothing to undo
+	if isUnversionedStatus(state.Status.ValueString()) {
+		return
+	}
+
 	// When deleting the versioning resource, set versioning to Suspended
-	err := r.client.UpdateS3BucketVersioning(bucketName, false, true)
+	err := r.client.UpdateS3BucketVersioning(ctx, bucketName, false, true)
 	if err != nil {
 		// Check if this is a conflict due to object lock being enabled
-		if strings.Contains(err.Error(), "Object Lock configuration is present") {
+		if utils.IsObjectLockVersioningConflict(err) {
 			// Add a warning but don't fail the delete operation
 			resp.Diagnostics.AddWarning(
 				"Cannot Modify Versioning on Object Lock Enabled Bucket",
@@ -260,7 +366,7 @@ func (r *S3BucketVersioningResource) ImportState(ctx context.Context, req resour
 	bucketName := req.ID
 
 	// Validate that the bucket exists and get versioning configuration
-	versioning, err := r.client.GetS3BucketVersioning(bucketName)
+	versioning, err := r.client.GetS3BucketVersioning(ctx, bucketName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Import S3 Bucket Versioning Configuration for %s", bucketName),
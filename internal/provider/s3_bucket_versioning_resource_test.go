@@ -68,10 +68,10 @@ func TestAPIBoolsToStatus(t *testing.T) {
 			want:      "Suspended",
 		},
 		{
-			name:      "disabled",
+			name:      "unversioned",
 			enabled:   false,
 			suspended: false,
-			want:      "Disabled",
+			want:      "Unversioned",
 		},
 		{
 			name:      "enabled wins if both flags are true",
@@ -89,3 +89,51 @@ func TestAPIBoolsToStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateVersioningTransition(t *testing.T) {
+	tests := []struct {
+		current string
+		planned string
+		wantErr bool
+	}{
+		{current: "Unversioned", planned: "Unversioned"},
+		{current: "Unversioned", planned: "Enabled"},
+		{current: "Unversioned", planned: "Suspended"},
+		{current: "Enabled", planned: "Suspended"},
+		{current: "Suspended", planned: "Enabled"},
+		{current: "Enabled", planned: "Unversioned", wantErr: true},
+		{current: "Suspended", planned: "Unversioned", wantErr: true},
+		{current: "Disabled", planned: "Unversioned"},
+		{current: "Unversioned", planned: "Disabled"},
+		{current: "Enabled", planned: "Disabled", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+" to "+tt.planned, func(t *testing.T) {
+			if got := validateVersioningTransition(tt.current, tt.planned).HasError(); got != tt.wantErr {
+				t.Fatalf("validateVersioningTransition(%q, %q) error = %v, want %v", tt.current, tt.planned, got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeepDeprecatedStatus(t *testing.T) {
+	tests := []struct {
+		status      string
+		stateStatus string
+		want        string
+	}{
+		{status: "Unversioned", stateStatus: "Disabled", want: "Disabled"},
+		{status: "Unversioned", stateStatus: "Unversioned", want: "Unversioned"},
+		{status: "Unversioned", stateStatus: "", want: "Unversioned"},
+		{status: "Enabled", stateStatus: "Disabled", want: "Enabled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status+" with "+tt.stateStatus, func(t *testing.T) {
+			if got := keepDeprecatedStatus(tt.status, tt.stateStatus); got != tt.want {
+				t.Fatalf("keepDeprecatedStatus(%q, %q) = %q, want %q", tt.status, tt.stateStatus, got, tt.want)
+			}
+		})
+	}
+}
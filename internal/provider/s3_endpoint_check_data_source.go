@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &S3EndpointCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &S3EndpointCheckDataSource{}
+)
+
+func NewS3EndpointCheckDataSource() datasource.DataSource {
+	return &S3EndpointCheckDataSource{}
+}
+
+// S3EndpointCheckDataSource defines the data source implementation.
+type S3EndpointCheckDataSource struct {
+	client *utils.Client
+}
+
+// S3EndpointCheckDataSourceModel describes the data source data model.
+type S3EndpointCheckDataSourceModel struct {
+	FailOnError types.Bool   `tfsdk:"fail_on_error"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+	Reachable   types.Bool   `tfsdk:"reachable"`
+	LatencyMs   types.Int64  `tfsdk:"latency_ms"`
+	BucketCount types.Int64  `tfsdk:"bucket_count"`
+	Error       types.String `tfsdk:"error"`
+}
+
+func (d *S3EndpointCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_endpoint_check"
+}
+
+func (d *S3EndpointCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks that the StorageGrid S3 endpoint is reachable with the provider credentials by performing a ListBuckets request. " +
+			"Use it to make configurations that depend on the S3 data path fail early with a precise reason.",
+		Attributes: map[string]schema.Attribute{
+			"fail_on_error": schema.BoolAttribute{
+				Description: "Whether a failed check is reported as an error. Set to false to only record the result in `reachable` and `error`. Defaults to true.",
+				Optional:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The S3 endpoint that was checked.",
+				Computed:    true,
+			},
+			"reachable": schema.BoolAttribute{
+				Description: "Whether the ListBuckets request succeeded.",
+				Computed:    true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				Description: "The latency of the ListBuckets request in milliseconds. Null when the request was not sent.",
+				Computed:    true,
+			},
+			"bucket_count": schema.Int64Attribute{
+				Description: "The number of buckets returned by the ListBuckets request.",
+				Computed:    true,
+			},
+			"error": schema.StringAttribute{
+				Description: "The reason the check failed. Null when the endpoint is reachable.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *S3EndpointCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *S3EndpointCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state S3EndpointCheckDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.CheckS3Endpoint(ctx)
+
+	state.Endpoint = types.StringNull()
+	state.LatencyMs = types.Int64Null()
+	state.BucketCount = types.Int64Null()
+	state.Error = types.StringNull()
+	if result != nil {
+		state.Endpoint = types.StringValue(result.Endpoint)
+		if result.Latency > 0 {
+			state.LatencyMs = types.Int64Value(result.Latency.Milliseconds())
+		}
+	}
+
+	if err != nil {
+		state.Reachable = types.BoolValue(false)
+		state.Error = types.StringValue(err.Error())
+
+		if state.FailOnError.IsNull() || state.FailOnError.ValueBool() {
+			resp.Diagnostics.AddError(
+				"S3 Endpoint Check Failed",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		state.Reachable = types.BoolValue(true)
+		state.BucketCount = types.Int64Value(int64(result.BucketCount))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
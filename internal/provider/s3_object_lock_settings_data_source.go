@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &S3ObjectLockSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &S3ObjectLockSettingsDataSource{}
+)
+
+func NewS3ObjectLockSettingsDataSource() datasource.DataSource {
+	return &S3ObjectLockSettingsDataSource{}
+}
+
+// S3ObjectLockSettingsDataSource defines the data source implementation.
+type S3ObjectLockSettingsDataSource struct {
+	client *utils.Client
+}
+
+// S3ObjectLockSettingsDataSourceModel describes the data source data model.
+type S3ObjectLockSettingsDataSourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (d *S3ObjectLockSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_object_lock_settings"
+}
+
+func (d *S3ObjectLockSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the grid-wide S3 Object Lock setting visible to the tenant. " +
+			"StorageGrid only defines default retention per bucket; there is no tenant- or grid-level default retention mode or period to inherit.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether S3 Object Lock is enabled for the grid. Buckets can only be created with object lock enabled when this is true.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *S3ObjectLockSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *S3ObjectLockSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state S3ObjectLockSettingsDataSourceModel
+
+	settings, err := d.client.GetS3ObjectLockSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read S3 Object Lock Settings",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Enabled = types.BoolValue(settings.Enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,469 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &S3ObjectLockSettingsResource{}
+	_ resource.ResourceWithConfigure   = &S3ObjectLockSettingsResource{}
+	_ resource.ResourceWithImportState = &S3ObjectLockSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &S3ObjectLockSettingsResource{}
+)
+
+func NewS3ObjectLockSettingsResource() resource.Resource {
+	return &S3ObjectLockSettingsResource{}
+}
+
+// S3ObjectLockSettingsResource defines the resource implementation.
+type S3ObjectLockSettingsResource struct {
+	client *utils.Client
+}
+
+// S3ObjectLockSettingsResourceModel describes the resource data model.
+type S3ObjectLockSettingsResourceModel struct {
+	BucketName                types.String   `tfsdk:"bucket_name"`
+	Key                       types.String   `tfsdk:"key"`
+	VersionID                 types.String   `tfsdk:"version_id"`
+	RetentionMode             types.String   `tfsdk:"retention_mode"`
+	RetainUntilDate           types.String   `tfsdk:"retain_until_date"`
+	LegalHold                 types.Bool     `tfsdk:"legal_hold"`
+	BypassGovernanceRetention types.Bool     `tfsdk:"bypass_governance_retention"`
+	ID                        types.String   `tfsdk:"id"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *S3ObjectLockSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_object_lock_settings"
+}
+
+func (r *S3ObjectLockSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the retention and legal hold of an object in a StorageGrid S3 bucket with object lock enabled. " +
+			"Unlike the storagegrid_s3_object_lock_settings data source, which reads the grid-wide object lock setting, this resource applies to a single object version. " +
+			"Destroying the resource removes the legal hold. A governance mode retention is only removed with bypass_governance_retention, and a compliance mode retention remains until it expires.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: "The name of the S3 bucket holding the object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The key of the object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version_id": schema.StringAttribute{
+				Description: "The version of the object. Defaults to the current version.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention_mode": schema.StringAttribute{
+				Description: "The retention mode (compliance or governance). Requires retain_until_date.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("compliance", "governance"),
+					stringvalidator.AlsoRequires(path.MatchRoot("retain_until_date")),
+				},
+			},
+			"retain_until_date": schema.StringAttribute{
+				Description: "The date until which the object version is retained (RFC 3339 format, such as 2030-01-01T00:00:00Z). Requires retention_mode.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("retention_mode")),
+				},
+			},
+			"legal_hold": schema.BoolAttribute{
+				Description: "Whether the object version is under legal hold. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"bypass_governance_retention": schema.BoolAttribute{
+				Description: "Whether to bypass a governance mode retention to shorten or remove it, which requires the s3:BypassGovernanceRetention permission. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Description: "The identifier of the object lock settings, the bucket name and key separated by a slash.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *S3ObjectLockSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan reports retention changes that StorageGrid rejects before anything is applied.
+func (r *S3ObjectLockSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan S3ObjectLockSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateRetainUntilDate(plan.RetainUntilDate)...)
+
+	// Nothing else to check on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state S3ObjectLockSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateObjectRetentionChange(state, plan)...)
+}
+
+// validateRetainUntilDate checks that retain_until_date is an RFC 3339 timestamp.
+func validateRetainUntilDate(date types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if date.IsNull() || date.IsUnknown() {
+		return diags
+	}
+	if _, err := time.Parse(time.RFC3339, date.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path.Root("retain_until_date"),
+			"Invalid Retain Until Date",
+			fmt.Sprintf("Could not parse retain_until_date as an RFC 3339 timestamp: %s", err.Error()),
+		)
+	}
+	return diags
+}
+
+// validateObjectRetentionChange checks that the retention of plan may replace the retention
+// of state. A compliance mode retention can only be extended, and a governance mode
+// retention can only be shortened, removed or switched to another mode with
+// bypass_governance_retention.
+func validateObjectRetentionChange(state, plan S3ObjectLockSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.RetentionMode.IsNull() || plan.RetentionMode.IsUnknown() || plan.RetainUntilDate.IsUnknown() {
+		return diags
+	}
+	if plan.RetentionMode.Equal(state.RetentionMode) && plan.RetainUntilDate.Equal(state.RetainUntilDate) {
+		return diags
+	}
+
+	current, err := time.Parse(time.RFC3339, state.RetainUntilDate.ValueString())
+	if err != nil {
+		return diags
+	}
+	extended := false
+	if !plan.RetainUntilDate.IsNull() {
+		planned, err := time.Parse(time.RFC3339, plan.RetainUntilDate.ValueString())
+		if err != nil {
+			return diags
+		}
+		extended = !planned.Before(current)
+	}
+	if plan.RetentionMode.Equal(state.RetentionMode) && extended {
+		return diags
+	}
+
+	switch state.RetentionMode.ValueString() {
+	case "compliance":
+		diags.AddAttributeError(
+			path.Root("retain_until_date"),
+			"Compliance Retention Cannot Be Reduced",
+			fmt.Sprintf("The object is retained in compliance mode until %s. A compliance mode retention can only be extended, it cannot be shortened, removed or changed to governance mode.", state.RetainUntilDate.ValueString()),
+		)
+	case "governance":
+		if !plan.BypassGovernanceRetention.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("retain_until_date"),
+				"Governance Retention Requires Bypass",
+				fmt.Sprintf("The object is retained in governance mode until %s. Set bypass_governance_retention to true to shorten, remove or change this retention.", state.RetainUntilDate.ValueString()),
+			)
+		}
+	}
+	return diags
+}
+
+// objectRetention converts the retention of the Terraform model into the API model, or
+// nil for no retention.
+func objectRetention(model S3ObjectLockSettingsResourceModel) (*utils.ObjectRetention, error) {
+	if model.RetentionMode.IsNull() {
+		return nil, nil
+	}
+	date, err := time.Parse(time.RFC3339, model.RetainUntilDate.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid retain_until_date: %w", err)
+	}
+	return &utils.ObjectRetention{Mode: model.RetentionMode.ValueString(), RetainUntilDate: date}, nil
+}
+
+// applyObjectLockStatus sets the settings read from StorageGrid in model. The
+// retain_until_date of model is kept if it means the same time, so that equivalent
+// timestamps do not show as a change.
+func applyObjectLockStatus(model *S3ObjectLockSettingsResourceModel, status *utils.ObjectLockStatus) {
+	model.LegalHold = types.BoolValue(status.LegalHold)
+	if status.Retention == nil {
+		model.RetentionMode = types.StringNull()
+		model.RetainUntilDate = types.StringNull()
+		return
+	}
+
+	model.RetentionMode = types.StringValue(status.Retention.Mode)
+	if current, err := time.Parse(time.RFC3339, model.RetainUntilDate.ValueString()); err == nil && current.Equal(status.Retention.RetainUntilDate) {
+		return
+	}
+	model.RetainUntilDate = types.StringValue(status.Retention.RetainUntilDate.UTC().Format(time.RFC3339))
+}
+
+func (r *S3ObjectLockSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan S3ObjectLockSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Create, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName, key, versionID := plan.BucketName.ValueString(), plan.Key.ValueString(), plan.VersionID.ValueString()
+
+	retention, err := objectRetention(plan)
+	if err == nil && retention != nil {
+		err = r.client.PutS3ObjectRetention(ctx, bucketName, key, versionID, retention, false)
+	}
+	if err == nil && plan.LegalHold.ValueBool() {
+		err = r.client.PutS3ObjectLegalHold(ctx, bucketName, key, versionID, true)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create S3 Object Lock Settings for %s/%s", bucketName, key),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(bucketName + "/" + key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3ObjectLockSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state S3ObjectLockSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName, key := state.BucketName.ValueString(), state.Key.ValueString()
+	status, err := r.client.GetS3ObjectLockStatus(ctx, bucketName, key, state.VersionID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read S3 Object Lock Settings for %s/%s", bucketName, key),
+			err.Error(),
+		)
+		return
+	}
+
+	applyObjectLockStatus(&state, status)
+	state.ID = types.StringValue(bucketName + "/" + key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *S3ObjectLockSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state S3ObjectLockSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := operationContext(ctx, plan.Timeouts.Update, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName, key, versionID := plan.BucketName.ValueString(), plan.Key.ValueString(), plan.VersionID.ValueString()
+
+	var err error
+	if !plan.RetentionMode.Equal(state.RetentionMode) || !plan.RetainUntilDate.Equal(state.RetainUntilDate) {
+		var retention *utils.ObjectRetention
+		retention, err = objectRetention(plan)
+		if err == nil {
+			err = r.client.PutS3ObjectRetention(ctx, bucketName, key, versionID, retention, plan.BypassGovernanceRetention.ValueBool())
+		}
+	}
+	if err == nil && !plan.LegalHold.Equal(state.LegalHold) {
+		err = r.client.PutS3ObjectLegalHold(ctx, bucketName, key, versionID, plan.LegalHold.ValueBool())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update S3 Object Lock Settings for %s/%s", bucketName, key),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *S3ObjectLockSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state S3ObjectLockSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := operationContext(ctx, state.Timeouts.Delete, defaultOperationTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName, key, versionID := state.BucketName.ValueString(), state.Key.ValueString(), state.VersionID.ValueString()
+
+	if state.LegalHold.ValueBool() {
+		err := r.client.PutS3ObjectLegalHold(ctx, bucketName, key, versionID, false)
+		if err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Delete S3 Object Lock Settings for %s/%s", bucketName, key),
+				fmt.Sprintf("Could not remove the legal hold: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	if state.RetentionMode.IsNull() {
+		return
+	}
+	if state.RetentionMode.ValueString() == "governance" && state.BypassGovernanceRetention.ValueBool() {
+		err := r.client.PutS3ObjectRetention(ctx, bucketName, key, versionID, nil, true)
+		if err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Delete S3 Object Lock Settings for %s/%s", bucketName, key),
+				fmt.Sprintf("Could not remove the governance mode retention: %s", err.Error()),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Object Retention Remains",
+		fmt.Sprintf("The object lock settings of %s/%s have been removed from Terraform state, but the object remains retained in %s mode until %s.",
+			bucketName, key, state.RetentionMode.ValueString(), state.RetainUntilDate.ValueString()),
+	)
+}
+
+func (r *S3ObjectLockSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using "bucket_name/key", where the key may hold further slashes
+	bucketName, key, ok := strings.Cut(req.ID, "/")
+	if !ok || bucketName == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form bucket_name/key, got: %q", req.ID),
+		)
+		return
+	}
+
+	state := S3ObjectLockSettingsResourceModel{
+		BucketName:                types.StringValue(bucketName),
+		Key:                       types.StringValue(key),
+		VersionID:                 types.StringNull(),
+		BypassGovernanceRetention: types.BoolValue(false),
+		ID:                        types.StringValue(req.ID),
+		Timeouts:                  nullTimeouts(),
+	}
+
+	status, err := r.client.GetS3ObjectLockStatus(ctx, bucketName, key, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import S3 Object Lock Settings for %s/%s", bucketName, key),
+			fmt.Sprintf("Object does not exist or its object lock settings are not accessible: %s", err.Error()),
+		)
+		return
+	}
+	applyObjectLockStatus(&state, status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
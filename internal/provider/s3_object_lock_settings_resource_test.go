@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func objectLockSettingsModel(mode, date string, bypass bool) S3ObjectLockSettingsResourceModel {
+	model := S3ObjectLockSettingsResourceModel{
+		RetentionMode:             types.StringNull(),
+		RetainUntilDate:           types.StringNull(),
+		BypassGovernanceRetention: types.BoolValue(bypass),
+	}
+	if mode != "" {
+		model.RetentionMode = types.StringValue(mode)
+		model.RetainUntilDate = types.StringValue(date)
+	}
+	return model
+}
+
+func TestValidateObjectRetentionChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   S3ObjectLockSettingsResourceModel
+		plan    S3ObjectLockSettingsResourceModel
+		wantErr bool
+	}{
+		{
+			name:  "add retention",
+			state: objectLockSettingsModel("", "", false),
+			plan:  objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", false),
+		},
+		{
+			name:  "extend compliance retention",
+			state: objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", false),
+			plan:  objectLockSettingsModel("compliance", "2031-01-01T00:00:00Z", false),
+		},
+		{
+			name:  "same compliance retention in another time zone",
+			state: objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", false),
+			plan:  objectLockSettingsModel("compliance", "2030-01-01T01:00:00+01:00", false),
+		},
+		{
+			name:    "shorten compliance retention",
+			state:   objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", true),
+			plan:    objectLockSettingsModel("compliance", "2029-01-01T00:00:00Z", true),
+			wantErr: true,
+		},
+		{
+			name:    "remove compliance retention",
+			state:   objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", true),
+			plan:    objectLockSettingsModel("", "", true),
+			wantErr: true,
+		},
+		{
+			name:    "change compliance to governance",
+			state:   objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", true),
+			plan:    objectLockSettingsModel("governance", "2031-01-01T00:00:00Z", true),
+			wantErr: true,
+		},
+		{
+			name:  "extend governance retention",
+			state: objectLockSettingsModel("governance", "2030-01-01T00:00:00Z", false),
+			plan:  objectLockSettingsModel("governance", "2031-01-01T00:00:00Z", false),
+		},
+		{
+			name:    "shorten governance retention without bypass",
+			state:   objectLockSettingsModel("governance", "2030-01-01T00:00:00Z", false),
+			plan:    objectLockSettingsModel("governance", "2029-01-01T00:00:00Z", false),
+			wantErr: true,
+		},
+		{
+			name:  "remove governance retention with bypass",
+			state: objectLockSettingsModel("governance", "2030-01-01T00:00:00Z", false),
+			plan:  objectLockSettingsModel("", "", true),
+		},
+		{
+			name:  "change governance to compliance with bypass",
+			state: objectLockSettingsModel("governance", "2030-01-01T00:00:00Z", false),
+			plan:  objectLockSettingsModel("compliance", "2030-01-01T00:00:00Z", true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateObjectRetentionChange(tt.state, tt.plan)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("validateObjectRetentionChange() errors = %v, want error %t", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRetainUntilDate(t *testing.T) {
+	if diags := validateRetainUntilDate(types.StringValue("2030-01-01T00:00:00Z")); diags.HasError() {
+		t.Fatalf("unexpected errors for an RFC 3339 date: %v", diags)
+	}
+	if diags := validateRetainUntilDate(types.StringValue("2030-01-01")); !diags.HasError() {
+		t.Fatal("expected an error for a date without time")
+	}
+}
+
+func TestApplyObjectLockStatus(t *testing.T) {
+	model := objectLockSettingsModel("governance", "2030-01-01T01:00:00+01:00", false)
+	applyObjectLockStatus(&model, &utils.ObjectLockStatus{
+		Retention: &utils.ObjectRetention{Mode: "governance", RetainUntilDate: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+		LegalHold: true,
+	})
+	if got := model.RetainUntilDate.ValueString(); got != "2030-01-01T01:00:00+01:00" {
+		t.Errorf("retain_until_date = %q, want the configured representation of the same time", got)
+	}
+	if !model.LegalHold.ValueBool() {
+		t.Error("legal_hold = false, want true")
+	}
+
+	applyObjectLockStatus(&model, &utils.ObjectLockStatus{
+		Retention: &utils.ObjectRetention{Mode: "compliance", RetainUntilDate: time.Date(2031, 6, 1, 12, 0, 0, 0, time.UTC)},
+	})
+	if got := model.RetainUntilDate.ValueString(); got != "2031-06-01T12:00:00Z" {
+		t.Errorf("retain_until_date = %q, want 2031-06-01T12:00:00Z", got)
+	}
+	if got := model.RetentionMode.ValueString(); got != "compliance" {
+		t.Errorf("retention_mode = %q, want compliance", got)
+	}
+
+	applyObjectLockStatus(&model, &utils.ObjectLockStatus{})
+	if !model.RetentionMode.IsNull() || !model.RetainUntilDate.IsNull() {
+		t.Errorf("retention = %s until %s, want null", model.RetentionMode, model.RetainUntilDate)
+	}
+}
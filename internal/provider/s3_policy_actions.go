@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	pathpkg "path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// storageGridS3Actions lists the actions StorageGrid supports in group and bucket policies.
+// StorageGrid accepts policies with other actions, but silently ignores those actions.
+var storageGridS3Actions = []string{
+	// Bucket operations
+	"s3:CreateBucket",
+	"s3:DeleteBucket",
+	"s3:DeleteBucketMetadataNotification",
+	"s3:DeleteBucketPolicy",
+	"s3:DeleteReplicationConfiguration",
+	"s3:GetBucketAcl",
+	"s3:GetBucketCompliance",
+	"s3:GetBucketConsistency",
+	"s3:GetBucketCORS",
+	"s3:GetEncryptionConfiguration",
+	"s3:GetBucketLastAccessTime",
+	"s3:GetBucketLocation",
+	"s3:GetBucketMetadataNotification",
+	"s3:GetBucketNotification",
+	"s3:GetBucketObjectLockConfiguration",
+	"s3:GetBucketPolicy",
+	"s3:GetBucketTagging",
+	"s3:GetBucketVersioning",
+	"s3:GetLifecycleConfiguration",
+	"s3:GetReplicationConfiguration",
+	"s3:ListAllMyBuckets",
+	"s3:ListBucket",
+	"s3:ListBucketMultipartUploads",
+	"s3:ListBucketVersions",
+	"s3:PutBucketCompliance",
+	"s3:PutBucketConsistency",
+	"s3:PutBucketCORS",
+	"s3:PutEncryptionConfiguration",
+	"s3:PutBucketLastAccessTime",
+	"s3:PutBucketMetadataNotification",
+	"s3:PutBucketNotification",
+	"s3:PutBucketObjectLockConfiguration",
+	"s3:PutBucketPolicy",
+	"s3:PutBucketTagging",
+	"s3:PutBucketVersioning",
+	"s3:PutLifecycleConfiguration",
+	"s3:PutReplicationConfiguration",
+
+	// Object operations
+	"s3:AbortMultipartUpload",
+	"s3:BypassGovernanceRetention",
+	"s3:DeleteObject",
+	"s3:DeleteObjectTagging",
+	"s3:DeleteObjectVersion",
+	"s3:DeleteObjectVersionTagging",
+	"s3:GetObject",
+	"s3:GetObjectAcl",
+	"s3:GetObjectLegalHold",
+	"s3:GetObjectRetention",
+	"s3:GetObjectTagging",
+	"s3:GetObjectVersion",
+	"s3:GetObjectVersionAcl",
+	"s3:GetObjectVersionTagging",
+	"s3:ListMultipartUploadParts",
+	"s3:PutObject",
+	"s3:PutObjectLegalHold",
+	"s3:PutObjectRetention",
+	"s3:PutObjectTagging",
+	"s3:PutObjectVersionTagging",
+	"s3:PutOverwriteObject",
+	"s3:RestoreObject",
+}
+
+// isSupportedS3Action reports whether StorageGrid implements an action. Actions are
+// matched case-insensitively, and wildcard actions are supported when they match at
+// least one supported action.
+func isSupportedS3Action(action string) bool {
+	pattern := strings.ToLower(action)
+	for _, supported := range storageGridS3Actions {
+		if matched, err := pathpkg.Match(pattern, strings.ToLower(supported)); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// unsupportedS3Actions returns the actions of a policy that StorageGrid does not
+// implement, each once and in the order they appear.
+func unsupportedS3Actions(policy utils.S3Policy) []string {
+	var unsupported []string
+	for _, stmt := range policy.Statement {
+		for _, action := range stmt.Action {
+			if !isSupportedS3Action(action) && !slices.Contains(unsupported, action) {
+				unsupported = append(unsupported, action)
+			}
+		}
+	}
+	return unsupported
+}
+
+// checkS3PolicyActions warns about the actions of a policy document that StorageGrid
+// ignores. Documents that are not valid policies are left to the other checks.
+func checkS3PolicyActions(attrPath path.Path, document string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policy utils.S3Policy
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return diags
+	}
+
+	if unsupported := unsupportedS3Actions(policy); len(unsupported) > 0 {
+		diags.AddAttributeWarning(
+			attrPath,
+			"Unsupported S3 Policy Actions",
+			fmt.Sprintf("StorageGrid does not implement the actions %s, so the policy grants or denies nothing for them. "+
+				"Set skip_s3_action_validation in the provider configuration to turn off this warning.", strings.Join(unsupported, ", ")),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestUnsupportedS3Actions(t *testing.T) {
+	policy := utils.S3Policy{Statement: []utils.Statement{
+		{Effect: "Allow", Action: utils.StringOrSlice{"s3:*"}},
+		{Effect: "Allow", Action: utils.StringOrSlice{"s3:getobject", "s3:List*", "s3:PutBucketAcl"}},
+		{Effect: "Deny", Action: utils.StringOrSlice{"s3:PutBucketAcl", "s3:PutObjectAcl*", "iam:CreateUser"}},
+	}}
+
+	want := []string{"s3:PutBucketAcl", "s3:PutObjectAcl*", "iam:CreateUser"}
+	if got := unsupportedS3Actions(policy); !slices.Equal(got, want) {
+		t.Fatalf("unsupportedS3Actions() = %#v, want %#v", got, want)
+	}
+}
+
+func TestCheckS3PolicyActions(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   string
+		wantWarn bool
+	}{
+		{name: "supported actions", policy: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"urn:sgws:s3:::logs/*"}]}`},
+		{name: "unsupported action", policy: `{"Statement":[{"Effect":"Allow","Action":"s3:PutBucketAcl","Resource":"urn:sgws:s3:::logs"}]}`, wantWarn: true},
+		{name: "invalid JSON", policy: `{"Statement":`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkS3PolicyActions(path.Root("policy"), tt.policy)
+			if diags.HasError() {
+				t.Fatalf("checkS3PolicyActions() returned errors: %v", diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarn {
+				t.Fatalf("checkS3PolicyActions() warnings = %v, want warning %t", diags, tt.wantWarn)
+			}
+		})
+	}
+}
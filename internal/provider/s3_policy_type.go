@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = S3PolicyType{}
+	_ basetypes.StringValuableWithSemanticEquals = S3PolicyValue{}
+	_ xattr.ValidateableAttribute                = S3PolicyValue{}
+)
+
+// S3PolicyType is a string type for S3 policy documents. Its values are semantically
+// equal when the policies are equivalent, so the framework keeps the document as it was
+// written when StorageGrid returns it reformatted or with statements in another order.
+type S3PolicyType struct {
+	basetypes.StringType
+}
+
+func (t S3PolicyType) String() string {
+	return "S3PolicyType"
+}
+
+func (t S3PolicyType) ValueType(ctx context.Context) attr.Value {
+	return S3PolicyValue{}
+}
+
+func (t S3PolicyType) Equal(o attr.Type) bool {
+	other, ok := o.(S3PolicyType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t S3PolicyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return S3PolicyValue{StringValue: in}, nil
+}
+
+func (t S3PolicyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// S3PolicyValue is a value of S3PolicyType.
+type S3PolicyValue struct {
+	basetypes.StringValue
+}
+
+// NewS3PolicyValue returns a known S3 policy value holding the policy document.
+func NewS3PolicyValue(policy string) S3PolicyValue {
+	return S3PolicyValue{StringValue: basetypes.NewStringValue(policy)}
+}
+
+func (v S3PolicyValue) Type(ctx context.Context) attr.Type {
+	return S3PolicyType{}
+}
+
+func (v S3PolicyValue) Equal(o attr.Value) bool {
+	other, ok := o.(S3PolicyValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values hold equivalent policies, ignoring
+// formatting, statement order and single values written as lists.
+func (v S3PolicyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(S3PolicyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. Expected %T, got %T. "+
+				"Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	equal, err := awspolicy.PoliciesAreEquivalent(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		diags.AddError("S3 Policy Comparison Error", "Failed to compare S3 policies: "+err.Error())
+		return false, diags
+	}
+
+	return equal, diags
+}
+
+// ValidateAttribute rejects values that are not JSON documents.
+func (v S3PolicyValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid S3 Policy JSON",
+			"The S3 policy must be a JSON document, use the `file()` or `jsonencode()` function to build it. Got: "+v.ValueString(),
+		)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestS3PolicyValueStringSemanticEquals(t *testing.T) {
+	policy := NewS3PolicyValue(`{"Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"urn:sgws:s3:::logs/*"}]}`)
+
+	tests := []struct {
+		name    string
+		other   string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "reformatted and reordered",
+			other: `{
+				"Statement": [
+					{"Effect": "Allow", "Resource": ["urn:sgws:s3:::logs/*"], "Action": ["s3:ListBucket", "s3:GetObject"], "Sid": "Read"}
+				]
+			}`,
+			want: true,
+		},
+		{
+			name:  "changed action",
+			other: `{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"urn:sgws:s3:::logs/*"}]}`,
+			want:  false,
+		},
+		{
+			name:    "invalid JSON",
+			other:   `{"Statement":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := policy.StringSemanticEquals(context.Background(), NewS3PolicyValue(tt.other))
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("StringSemanticEquals() diagnostics = %v, want error %t", diags, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("StringSemanticEquals() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestS3PolicyValueValidateAttribute(t *testing.T) {
+	tests := []struct {
+		policy  string
+		wantErr bool
+	}{
+		{policy: `{"Statement":[]}`},
+		{policy: `not json`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var resp xattr.ValidateAttributeResponse
+		NewS3PolicyValue(tt.policy).ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("s3")}, &resp)
+		if resp.Diagnostics.HasError() != tt.wantErr {
+			t.Fatalf("ValidateAttribute(%q) diagnostics = %v, want error %t", tt.policy, resp.Diagnostics, tt.wantErr)
+		}
+	}
+}
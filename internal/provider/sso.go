@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Authentication modes of the auth_mode provider attribute.
+const (
+	authModePassword = "password"
+	authModeSSO      = "sso"
+)
+
+// samlResponseProcess returns a function that signs in to the identity provider by running
+// command with the sign-in URL as its last argument. The command prints the base64 encoded
+// SAML response. The username and password, when set, are passed to it in the
+// STORAGEGRID_USERNAME and STORAGEGRID_PASSWORD environment variables.
+func samlResponseProcess(command, username, password string) utils.SAMLResponseFunc {
+	return func(ctx context.Context, signInURL string) (string, error) {
+		var env []string
+		if username != "" {
+			env = append(env, "STORAGEGRID_USERNAME="+username)
+		}
+		if password != "" {
+			env = append(env, "STORAGEGRID_PASSWORD="+password)
+		}
+
+		output, err := runCommand(ctx, command, []string{signInURL}, env)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(output)), nil
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSAMLResponseProcess(t *testing.T) {
+	script := filepath.Join(t.TempDir(), "saml-response")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho \"$STORAGEGRID_USERNAME:$STORAGEGRID_PASSWORD:$1\"\n"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := samlResponseProcess(script, "jdoe", "secret")(t.Context(), "https://adfs.example.com/adfs/ls/?SAMLRequest=abc")
+	if err != nil {
+		t.Fatalf("samlResponseProcess() error = %v", err)
+	}
+	if want := "jdoe:secret:https://adfs.example.com/adfs/ls/?SAMLRequest=abc"; got != want {
+		t.Fatalf("samlResponseProcess() = %q, want %q", got, want)
+	}
+}
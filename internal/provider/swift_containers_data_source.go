@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SwiftContainersDataSource{}
+	_ datasource.DataSourceWithConfigure = &SwiftContainersDataSource{}
+)
+
+func NewSwiftContainersDataSource() datasource.DataSource {
+	return &SwiftContainersDataSource{}
+}
+
+// SwiftContainersDataSource defines the data source implementation.
+type SwiftContainersDataSource struct {
+	client *utils.Client
+}
+
+// SwiftContainersDataSourceModel describes the data source data model.
+type SwiftContainersDataSourceModel struct {
+	Containers      []SwiftContainerModel `tfsdk:"containers"`
+	CalculationTime types.String          `tfsdk:"calculation_time"`
+}
+
+// SwiftContainerModel represents the usage of a single Swift container.
+type SwiftContainerModel struct {
+	Name        types.String `tfsdk:"name"`
+	ObjectCount types.Int64  `tfsdk:"object_count"`
+	DataBytes   types.Int64  `tfsdk:"data_bytes"`
+}
+
+func (d *SwiftContainersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_swift_containers"
+}
+
+func (d *SwiftContainersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Swift containers of a StorageGrid Swift tenant account with their storage usage. " +
+			"Usage values are calculated periodically by StorageGrid and may lag behind recent changes. Reading it with an S3 tenant account fails.",
+		Attributes: map[string]schema.Attribute{
+			"containers": schema.ListNestedAttribute{
+				Description: "The Swift containers of the tenant account.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the Swift container.",
+							Computed:    true,
+						},
+						"object_count": schema.Int64Attribute{
+							Description: "The number of objects stored in the container.",
+							Computed:    true,
+						},
+						"data_bytes": schema.Int64Attribute{
+							Description: "The logical size in bytes of all objects stored in the container.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"calculation_time": schema.StringAttribute{
+				Description: "The time at which StorageGrid last calculated the usage values.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SwiftContainersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SwiftContainersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SwiftContainersDataSourceModel
+
+	resp.Diagnostics.Append(checkSwiftTenant(ctx, d.client, "Unable to Read Swift Containers")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := d.client.GetTenantUsage(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Swift Containers",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map API response data to the Terraform state model
+	state.Containers = []SwiftContainerModel{}
+	for _, container := range usage.Buckets {
+		state.Containers = append(state.Containers, SwiftContainerModel{
+			Name:        types.StringValue(container.Name),
+			ObjectCount: types.Int64Value(container.ObjectCount),
+			DataBytes:   types.Int64Value(container.DataBytes),
+		})
+	}
+	state.CalculationTime = types.StringValue(usage.CalculationTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// checkSwiftTenant returns an error diagnostic unless the tenant account is a Swift
+// tenant. The tenant usage lists the buckets of S3 tenants the same way as the
+// containers of Swift tenants.
+func checkSwiftTenant(ctx context.Context, client *utils.Client, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	account, err := client.GetTenantAccount(ctx)
+	if err != nil {
+		diags.AddError(summary, "Could not read the tenant account: "+err.Error())
+		return diags
+	}
+
+	if !account.HasCapability(utils.CapabilitySwift) {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The tenant account %s is not a Swift tenant. Use the storagegrid_s3_bucket data source to read the buckets of S3 tenants.", account.Name),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestSwiftContainersDataSourceRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities string
+		wantErr      string
+		wantCount    int
+	}{
+		{name: "swift tenant", capabilities: `["management", "swift"]`, wantCount: 1},
+		{name: "s3 tenant", capabilities: `["management", "s3"]`, wantErr: "is not a Swift tenant"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v4/org/account":
+					_, _ = w.Write([]byte(`{"data": {"id": "1", "name": "tenant", "capabilities": ` + tt.capabilities + `}}`))
+				case "/api/v4/org/usage":
+					_, _ = w.Write([]byte(`{"data": {"calculationTime": "2026-01-01T00:00:00.000Z", "buckets": [{"name": "logs", "objectCount": 2, "dataBytes": 10}]}}`))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			defer server.Close()
+
+			d := &SwiftContainersDataSource{client: &utils.Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+			}}
+
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"containers":       tftypes.NewValue(objectType.AttributeTypes["containers"], nil),
+				"calculation_time": tftypes.NewValue(tftypes.String, nil),
+			})
+
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("Read diagnostics = %v, want error containing %q", resp.Diagnostics, tt.wantErr)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var state SwiftContainersDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if len(state.Containers) != tt.wantCount {
+				t.Fatalf("containers = %v, want %d", state.Containers, tt.wantCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TenantAccountProfileResource{}
+	_ resource.ResourceWithConfigure   = &TenantAccountProfileResource{}
+	_ resource.ResourceWithImportState = &TenantAccountProfileResource{}
+)
+
+// NewTenantAccountProfileResource is a factory function for the tenant account profile resource.
+func NewTenantAccountProfileResource() resource.Resource {
+	return &TenantAccountProfileResource{}
+}
+
+// TenantAccountProfileResource manages the name and description of an existing tenant
+// account. It uses the grid administrator client.
+type TenantAccountProfileResource struct {
+	grid *utils.Client
+}
+
+// TenantAccountProfileResourceModel maps the resource schema data.
+type TenantAccountProfileResourceModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *TenantAccountProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_account_profile"
+}
+
+// Schema defines the resource's schema.
+func (r *TenantAccountProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the name and description of an existing tenant account. Requires the grid block of the provider configuration, " +
+			"as only grid administrators can update tenant accounts. Destroying the resource keeps the current name and description of the tenant account.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Description: "The ID of the tenant account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The display name of the tenant account.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the tenant account. The current description is kept when unset.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the tenant account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured grid client to the resource.
+func (r *TenantAccountProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.grid = providerData.Grid
+}
+
+// checkGrid returns an error when the provider has no grid administrator client.
+func (r *TenantAccountProfileResource) checkGrid() diag.Diagnostics {
+	return checkGridClient(r.grid, "storagegrid_tenant_account_profile", "updates the name and description of a tenant account")
+}
+
+// Create sets the name and description of the tenant account.
+func (r *TenantAccountProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.checkGrid()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkReadOnly(r.grid, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan TenantAccountProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.updateProfile(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update Tenant Account %s", plan.AccountID.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tenantAccountProfileToModel(account))...)
+}
+
+// Read refreshes the name and description of the tenant account, and removes the
+// resource from state when the tenant account no longer exists.
+func (r *TenantAccountProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.checkGrid()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TenantAccountProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.grid.GetGridAccount(ctx, state.AccountID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read Tenant Account %s", state.AccountID.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tenantAccountProfileToModel(account))...)
+}
+
+// Update sets the name and description of the tenant account.
+func (r *TenantAccountProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.checkGrid()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkReadOnly(r.grid, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan TenantAccountProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.updateProfile(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update Tenant Account %s", plan.AccountID.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tenantAccountProfileToModel(account))...)
+}
+
+// Delete removes the resource from state. The tenant account keeps its current name and
+// description.
+func (r *TenantAccountProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.grid, "delete")...)
+}
+
+// ImportState imports the profile of a tenant account by its ID.
+func (r *TenantAccountProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("account_id"), req, resp)
+}
+
+// updateProfile writes the planned name and description and reads the tenant account back.
+func (r *TenantAccountProfileResource) updateProfile(ctx context.Context, plan TenantAccountProfileResourceModel) (*utils.GridAccountData, error) {
+	accountID := plan.AccountID.ValueString()
+	// An unknown description keeps the current one of the tenant account
+	var description *string
+	if !plan.Description.IsUnknown() && !plan.Description.IsNull() {
+		description = plan.Description.ValueStringPointer()
+	}
+
+	if err := r.grid.UpdateGridAccountProfile(ctx, accountID, plan.Name.ValueString(), description); err != nil {
+		return nil, err
+	}
+	return r.grid.GetGridAccount(ctx, accountID)
+}
+
+// tenantAccountProfileToModel converts a tenant account into the Terraform model.
+func tenantAccountProfileToModel(account *utils.GridAccountData) TenantAccountProfileResourceModel {
+	return TenantAccountProfileResourceModel{
+		AccountID:   types.StringValue(account.ID),
+		Name:        types.StringValue(account.Name),
+		Description: types.StringValue(account.Description),
+		ID:          types.StringValue(account.ID),
+	}
+}
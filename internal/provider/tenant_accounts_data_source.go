@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TenantAccountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TenantAccountsDataSource{}
+)
+
+func NewTenantAccountsDataSource() datasource.DataSource {
+	return &TenantAccountsDataSource{}
+}
+
+// TenantAccountsDataSource defines the data source implementation. It uses the grid
+// administrator client.
+type TenantAccountsDataSource struct {
+	grid *utils.Client
+}
+
+// TenantAccountsDataSourceModel describes the data source data model.
+type TenantAccountsDataSourceModel struct {
+	NamePrefix types.String         `tfsdk:"name_prefix"`
+	Accounts   []TenantAccountModel `tfsdk:"accounts"`
+}
+
+// TenantAccountModel describes a single tenant account of the list.
+type TenantAccountModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Capabilities             []types.String `tfsdk:"capabilities"`
+	UseAccountIdentitySource types.Bool     `tfsdk:"use_account_identity_source"`
+	AllowPlatformServices    types.Bool     `tfsdk:"allow_platform_services"`
+	QuotaObjectBytes         types.Int64    `tfsdk:"quota_object_bytes"`
+}
+
+func (d *TenantAccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_accounts"
+}
+
+func (d *TenantAccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the tenant accounts of the grid. Requires the grid block of the provider configuration, as only grid administrators can list tenant accounts.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list tenant accounts whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"accounts": schema.ListNestedAttribute{
+				Description: "The tenant accounts of the grid.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the tenant account, used as accountid in the provider configuration.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The display name of the tenant account.",
+							Computed:    true,
+						},
+						"capabilities": schema.ListAttribute{
+							Description: "The capabilities of the tenant account, such as 'management', 's3' or 'swift'.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"use_account_identity_source": schema.BoolAttribute{
+							Description: "Whether the tenant account uses its own identity source instead of the one of the grid.",
+							Computed:    true,
+						},
+						"allow_platform_services": schema.BoolAttribute{
+							Description: "Whether the tenant account may use platform services such as CloudMirror replication and notifications.",
+							Computed:    true,
+						},
+						"quota_object_bytes": schema.Int64Attribute{
+							Description: "The storage quota of the tenant account in bytes, null when the tenant account has no quota.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TenantAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.grid = providerData.Grid
+}
+
+func (d *TenantAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TenantAccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkGridClient(d.grid, "storagegrid_tenant_accounts", "lists the tenant accounts of the grid")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := d.grid.ListGridAccounts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Tenant Accounts",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map API response data to the Terraform state model
+	state.Accounts = []TenantAccountModel{}
+	for _, account := range accounts {
+		if !strings.HasPrefix(account.Name, state.NamePrefix.ValueString()) {
+			continue
+		}
+		state.Accounts = append(state.Accounts, tenantAccountToModel(account))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// tenantAccountToModel converts a tenant account of the list into the Terraform model.
+func tenantAccountToModel(account utils.GridAccountData) TenantAccountModel {
+	capabilities := make([]types.String, 0, len(account.Capabilities))
+	for _, capability := range account.Capabilities {
+		capabilities = append(capabilities, types.StringValue(capability))
+	}
+
+	return TenantAccountModel{
+		ID:                       types.StringValue(account.ID),
+		Name:                     types.StringValue(account.Name),
+		Capabilities:             capabilities,
+		UseAccountIdentitySource: types.BoolValue(account.Policy.UseAccountIdentitySource),
+		AllowPlatformServices:    types.BoolValue(account.Policy.AllowPlatformServices),
+		QuotaObjectBytes:         types.Int64PointerValue(account.Policy.QuotaObjectBytes),
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestTenantAccountToModel(t *testing.T) {
+	quota := int64(1 << 40)
+	model := tenantAccountToModel(utils.GridAccountData{
+		ID:           "12345678901234567890",
+		Name:         "analytics",
+		Capabilities: []string{"management", "s3"},
+		Policy:       utils.GridAccountPolicy{AllowPlatformServices: true, QuotaObjectBytes: &quota},
+	})
+
+	if model.Name.ValueString() != "analytics" || !model.AllowPlatformServices.ValueBool() || model.QuotaObjectBytes.ValueInt64() != quota {
+		t.Fatalf("model = %#v", model)
+	}
+	if want := []types.String{types.StringValue("management"), types.StringValue("s3")}; !slices.Equal(model.Capabilities, want) {
+		t.Fatalf("capabilities = %v, want %v", model.Capabilities, want)
+	}
+
+	if model := tenantAccountToModel(utils.GridAccountData{ID: "1"}); !model.QuotaObjectBytes.IsNull() {
+		t.Fatalf("quota_object_bytes = %s, want null without a quota", model.QuotaObjectBytes)
+	}
+}
+
+func TestTenantAccountsDataSourceRequiresGridClient(t *testing.T) {
+	ctx := context.Background()
+	d := &TenantAccountsDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: &ProviderData{Client: &utils.Client{}}}, &datasource.ConfigureResponse{})
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name_prefix": tftypes.NewValue(tftypes.String, nil),
+		"accounts":    tftypes.NewValue(objectType.AttributeTypes["accounts"], nil),
+	})
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Grid Administrator Credentials Required" {
+		t.Fatalf("Read diagnostics = %v, want the grid credentials error", resp.Diagnostics)
+	}
+}
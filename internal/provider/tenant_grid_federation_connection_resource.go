@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TenantGridFederationConnectionResource{}
+	_ resource.ResourceWithConfigure   = &TenantGridFederationConnectionResource{}
+	_ resource.ResourceWithImportState = &TenantGridFederationConnectionResource{}
+)
+
+// NewTenantGridFederationConnectionResource is a factory function for the tenant grid
+// federation connection resource.
+func NewTenantGridFederationConnectionResource() resource.Resource {
+	return &TenantGridFederationConnectionResource{}
+}
+
+// TenantGridFederationConnectionResource grants a tenant account the permission to use a
+// grid federation connection for cross-grid replication. It uses the grid administrator
+// client.
+type TenantGridFederationConnectionResource struct {
+	grid *utils.Client
+}
+
+// TenantGridFederationConnectionResourceModel maps the resource schema data.
+type TenantGridFederationConnectionResourceModel struct {
+	AccountID        types.String `tfsdk:"account_id"`
+	GridConnectionID types.String `tfsdk:"grid_connection_id"`
+	ID               types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *TenantGridFederationConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_grid_federation_connection"
+}
+
+// Schema defines the resource's schema.
+func (r *TenantGridFederationConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants a tenant account the permission to use a grid federation connection, which the cross-grid replication of its buckets " +
+			"(storagegrid_s3_bucket_cross_grid_replication) requires. Destroying the resource revokes the permission. " +
+			"Requires the grid block of the provider configuration, as only grid administrators can update tenant accounts.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Description: "The ID of the tenant account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grid_connection_id": schema.StringAttribute{
+				Description: "The ID of the grid federation connection the tenant account may use.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The identifier of the permission, of the form account_id/grid_connection_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured grid client to the resource.
+func (r *TenantGridFederationConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.grid = providerData.Grid
+}
+
+// checkGrid returns an error when the provider has no grid administrator client.
+func (r *TenantGridFederationConnectionResource) checkGrid() diag.Diagnostics {
+	return checkGridClient(r.grid, "storagegrid_tenant_grid_federation_connection", "grants tenant accounts the use of grid federation connections")
+}
+
+// Create grants the tenant account the use of the grid federation connection.
+func (r *TenantGridFederationConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.checkGrid()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkReadOnly(r.grid, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan TenantGridFederationConnectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, connectionID := plan.AccountID.ValueString(), plan.GridConnectionID.ValueString()
+	if err := r.grid.SetGridAccountFederationConnection(ctx, accountID, connectionID, true); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Grant Grid Federation Connection %s to Tenant Account %s", connectionID, accountID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(accountID + "/" + connectionID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the resource from state when the tenant account no longer exists or may no
+// longer use the grid federation connection.
+func (r *TenantGridFederationConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.checkGrid()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TenantGridFederationConnectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.grid.GetGridAccount(ctx, state.AccountID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read Tenant Account %s", state.AccountID.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(account.GridFederationConnections, state.GridConnectionID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, as every attribute forces a replacement.
+func (r *TenantGridFederationConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TenantGridFederationConnectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the use of the grid federation connection from the tenant account.
+func (r *TenantGridFederationConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.checkGrid()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkReadOnly(r.grid, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TenantGridFederationConnectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, connectionID := state.AccountID.ValueString(), state.GridConnectionID.ValueString()
+	if err := r.grid.SetGridAccountFederationConnection(ctx, accountID, connectionID, false); err != nil {
+		// The permission is gone with the tenant account
+		if utils.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Revoke Grid Federation Connection %s from Tenant Account %s", connectionID, accountID),
+			err.Error(),
+		)
+	}
+}
+
+func (r *TenantGridFederationConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using "account_id/grid_connection_id"
+	accountID, connectionID, ok := strings.Cut(req.ID, "/")
+	if !ok || accountID == "" || connectionID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form account_id/grid_connection_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	state := TenantGridFederationConnectionResourceModel{
+		AccountID:        types.StringValue(accountID),
+		GridConnectionID: types.StringValue(connectionID),
+		ID:               types.StringValue(req.ID),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TenantQuotaUtilizationDataSource{}
+	_ datasource.DataSourceWithConfigure = &TenantQuotaUtilizationDataSource{}
+)
+
+func NewTenantQuotaUtilizationDataSource() datasource.DataSource {
+	return &TenantQuotaUtilizationDataSource{}
+}
+
+// TenantQuotaUtilizationDataSource defines the data source implementation.
+type TenantQuotaUtilizationDataSource struct {
+	client *utils.Client
+}
+
+// TenantQuotaUtilizationDataSourceModel describes the data source data model.
+type TenantQuotaUtilizationDataSourceModel struct {
+	QuotaBytes         types.Int64   `tfsdk:"quota_bytes"`
+	UsedBytes          types.Int64   `tfsdk:"used_bytes"`
+	ObjectCount        types.Int64   `tfsdk:"object_count"`
+	UtilizationPercent types.Float64 `tfsdk:"utilization_percent"`
+	QuotaEnabled       types.Bool    `tfsdk:"quota_enabled"`
+	CalculationTime    types.String  `tfsdk:"calculation_time"`
+}
+
+func (d *TenantQuotaUtilizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_quota_utilization"
+}
+
+func (d *TenantQuotaUtilizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the storage quota and current usage of the StorageGrid tenant account.",
+		Attributes: map[string]schema.Attribute{
+			"quota_bytes": schema.Int64Attribute{
+				Description: "The storage quota of the tenant in bytes. Null when no quota is configured.",
+				Computed:    true,
+			},
+			"used_bytes": schema.Int64Attribute{
+				Description: "The number of bytes currently stored by the tenant.",
+				Computed:    true,
+			},
+			"object_count": schema.Int64Attribute{
+				Description: "The number of objects currently stored by the tenant.",
+				Computed:    true,
+			},
+			"utilization_percent": schema.Float64Attribute{
+				Description: "The percentage of the quota currently in use. Null when no quota is configured.",
+				Computed:    true,
+			},
+			"quota_enabled": schema.BoolAttribute{
+				Description: "Whether a storage quota is configured for the tenant.",
+				Computed:    true,
+			},
+			"calculation_time": schema.StringAttribute{
+				Description: "The time at which StorageGrid last calculated the usage values.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *TenantQuotaUtilizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TenantQuotaUtilizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TenantQuotaUtilizationDataSourceModel
+
+	usage, err := d.client.GetTenantUsage(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tenant Usage",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map API response data to the Terraform state model
+	state.UsedBytes = types.Int64Value(usage.DataBytes)
+	state.ObjectCount = types.Int64Value(usage.ObjectCount)
+	state.CalculationTime = types.StringValue(usage.CalculationTime)
+
+	if utilization, ok := usage.QuotaUtilization(); ok {
+		state.QuotaEnabled = types.BoolValue(true)
+		state.QuotaBytes = types.Int64Value(*usage.QuotaObjectBytes)
+		state.UtilizationPercent = types.Float64Value(utilization)
+	} else {
+		state.QuotaEnabled = types.BoolValue(false)
+		state.QuotaBytes = types.Int64Null()
+		state.UtilizationPercent = types.Float64Null()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
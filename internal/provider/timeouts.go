@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOperationTimeout bounds create, update and delete operations of resources whose
+// timeouts block does not set a timeout for the operation.
+const defaultOperationTimeout = 20 * time.Minute
+
+// timeoutsBlock returns the timeouts block of resources with long-running operations.
+// The timeouts bound an operation as a whole, the provider timeouts bound each request.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true})
+}
+
+// nullTimeouts returns the timeouts of a resource without a timeouts block, for state
+// written on import.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
+
+// operationContext returns ctx bounded by the timeout of an operation, as returned by
+// one of the methods of timeouts.Value, with defaultTimeout when it is not set.
+func operationContext(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	duration, diags := timeout(ctx, defaultTimeout)
+	if diags.HasError() {
+		ctx, cancel := context.WithCancel(ctx)
+		return ctx, cancel, diags
+	}
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, cancel, diags
+}
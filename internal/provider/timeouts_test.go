@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOperationContext(t *testing.T) {
+	configured := timeouts.Value{Object: types.ObjectValueMust(
+		nullTimeouts().AttributeTypes(context.Background()),
+		map[string]attr.Value{
+			"create": types.StringValue("5m"),
+			"update": types.StringNull(),
+			"delete": types.StringValue("invalid"),
+		},
+	)}
+
+	tests := []struct {
+		name    string
+		timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "no timeouts block", timeout: nullTimeouts().Create, want: defaultOperationTimeout},
+		{name: "configured timeout", timeout: configured.Create, want: 5 * time.Minute},
+		{name: "unset timeout", timeout: configured.Update, want: defaultOperationTimeout},
+		{name: "invalid timeout", timeout: configured.Delete, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel, diags := operationContext(context.Background(), tt.timeout, defaultOperationTimeout)
+			defer cancel()
+
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("operationContext() diagnostics = %v, want error %v", diags, tt.wantErr)
+			}
+			deadline, ok := ctx.Deadline()
+			if tt.wantErr {
+				if ok {
+					t.Fatalf("unexpected deadline %s for an invalid timeout", deadline)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("context has no deadline")
+			}
+			if got := time.Until(deadline); got > tt.want || got < tt.want-time.Minute {
+				t.Fatalf("deadline in %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
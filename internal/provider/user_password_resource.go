@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &UserPasswordResource{}
+	_ resource.ResourceWithConfigure = &UserPasswordResource{}
+)
+
+// NewUserPasswordResource is a factory function for the user password resource.
+func NewUserPasswordResource() resource.Resource {
+	return &UserPasswordResource{}
+}
+
+// UserPasswordResource sets the password of a local user, independently of the
+// lifecycle of the user.
+type UserPasswordResource struct {
+	client *utils.Client
+}
+
+// UserPasswordResourceModel maps the resource schema data.
+type UserPasswordResourceModel struct {
+	UserName types.String `tfsdk:"user_name"`
+	// Password is write-only, it is only set in the configuration and never in plan or state
+	Password        types.String `tfsdk:"password"`
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *UserPasswordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_password"
+}
+
+// Schema defines the resource's schema.
+func (r *UserPasswordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the password of a local StorageGrid user, independently of the storagegrid_user resource. " +
+			"The password is set when the resource is created or replaced, e.g. when rotation_trigger changes or through replace_triggered_by. " +
+			"Destroying the resource keeps the current password of the user. Requires Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"user_name": schema.StringAttribute{
+				Description: "The name of the local user, without the 'user/' prefix.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the user, as a write-only argument that is never stored in the plan or state. " +
+					"Changing it alone does not set the password again, change rotation_trigger to rotate it. Must be at least 8 characters long.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"rotation_trigger": schema.MapAttribute{
+				Description: "Arbitrary values that set the password again when they change, such as a rotation date or the version of a secret.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique name of the user whose password is managed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *UserPasswordResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = providerData.Client
+}
+
+// Create sets the password of the user.
+func (r *UserPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan UserPasswordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	// The write-only password is only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uniqueName := "user/" + plan.UserName.ValueString()
+	if err := r.client.ChangeUserPassword(ctx, uniqueName, password.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Set Password for User %s", plan.UserName.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(uniqueName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the resource from state when the user no longer exists.
+func (r *UserPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserPasswordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetUser(ctx, state.ID.ValueString()); err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read User %s", state.UserName.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the plan, as every attribute that sets the password again forces a
+// replacement.
+func (r *UserPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan UserPasswordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from state. StorageGrid cannot unset a password, so the
+// user keeps the current one.
+func (r *UserPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+}
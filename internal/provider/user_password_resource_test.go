@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestUserPasswordResourceCreateKeepsPasswordOutOfState(t *testing.T) {
+	ctx := context.Background()
+
+	var gotPath, gotPassword string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		var payload utils.ChangePasswordPayload
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		gotPassword = payload.Password
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	r := &UserPasswordResource{client: &utils.Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	value := func(password any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"user_name":        tftypes.NewValue(tftypes.String, "ci"),
+			"password":         tftypes.NewValue(tftypes.String, password),
+			"rotation_trigger": tftypes.NewValue(objectType.AttributeTypes["rotation_trigger"], nil),
+			"id":               tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value("s3cret-password")},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(nil)},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+	if gotPath != "/api/v4/org/users/user/ci/change-password" || gotPassword != "s3cret-password" {
+		t.Fatalf("change-password request to %s with password %q", gotPath, gotPassword)
+	}
+
+	var password, id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("password"), &password)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if !password.IsNull() || id.ValueString() != "user/ci" {
+		t.Fatalf("state password = %s, id = %s, want null password and user/ci", password, id)
+	}
+}
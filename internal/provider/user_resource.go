@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
@@ -50,6 +53,10 @@ type UserResourceModel struct {
 	UniqueName types.String `tfsdk:"unique_name"`
 	UserURN    types.String `tfsdk:"user_urn"`
 	Federated  types.Bool   `tfsdk:"federated"`
+
+	// PasswordWO is write-only, it is only set in the configuration and never in plan or state
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 // Metadata returns the resource type name.
@@ -90,13 +97,31 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Default:     booldefault.StaticBool(false),
 			},
 			"password": schema.StringAttribute{
-				Description: "The password for the user. This field is write-only and will not be read from the API. Setting this value will trigger a password update. Must be at least 8 characters long. Note: The password will be stored in plain text in the Terraform state file.",
+				Description: "The password for the user. It is not read from the API. Setting this value will trigger a password update. Must be at least 8 characters long. Note: The password will be stored in plain text in the Terraform state file, use password_wo to keep it out of the state.",
 				Optional:    true,
 				Sensitive:   true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(8),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "The password for the user, as a write-only argument that is never stored in the plan or state. Requires Terraform 1.11 or later. " +
+					"The password is set when the user is created and whenever password_wo_version changes. Must be at least 8 characters long. Conflicts with password.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Version of password_wo. Since write-only arguments are not stored in the state, change the version to set a new password_wo.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
 			"id": schema.StringAttribute{
 				Description: "The unique identifier (ID) for the user, generated by StorageGrid.",
 				Computed:    true,
@@ -141,19 +166,24 @@ func (r *UserResource) Configure(_ context.Context, req resource.ConfigureReques
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*utils.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *utils.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create creates the user resource and sets the initial state.
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -170,7 +200,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 			return
 		}
 		for _, groupName := range groupNames {
-			apiGroup, err := r.client.GetGroup("group/" + groupName)
+			apiGroup, err := r.client.GetGroup(ctx, "group/"+groupName)
 			if err != nil {
 				resp.Diagnostics.AddError("Error Finding Group", fmt.Sprintf("Could not find group '%s' to add user to: %s", groupName, err.Error()))
 				return
@@ -191,18 +221,24 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Disable:    plan.Disable.ValueBool(),
 	}
 
-	createdUser, err := r.client.CreateUser(payload)
+	password, diags := configuredPassword(ctx, req.Config, plan.Password, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdUser, err := r.client.CreateUser(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating User", "Could not create user, unexpected error: "+err.Error())
 		return
 	}
 
 	// Set password if provided
-	if !plan.Password.IsNull() && !plan.Password.IsUnknown() {
-		err := r.client.ChangeUserPassword(createdUser.Data.UniqueName, plan.Password.ValueString())
+	if !password.IsNull() && !password.IsUnknown() {
+		err := r.client.ChangeUserPassword(ctx, createdUser.Data.UniqueName, password.ValueString())
 		if err != nil {
 			// Password setting failed - clean up the user we just created
-			deleteErr := r.client.DeleteUser(createdUser.Data.ID)
+			deleteErr := r.client.DeleteUser(ctx, createdUser.Data.ID)
 			if deleteErr != nil {
 				resp.Diagnostics.AddError(
 					"Error Setting User Password and Cleanup Failed",
@@ -240,9 +276,9 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	apiUser, err := r.client.GetUser(state.ID.ValueString())
+	apiUser, err := r.client.GetUser(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
+		if utils.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -254,7 +290,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	groupNames := make([]string, 0)
 	for _, groupID := range userData.MemberOf {
-		group, err := r.client.GetGroup(groupID)
+		group, err := r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Could Not Read Member Group", fmt.Sprintf("User is a member of group with ID %s, but it could not be fetched: %s", groupID, err.Error()))
 			continue
@@ -280,9 +316,15 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan UserResourceModel
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -296,7 +338,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 		for _, groupName := range groupNames {
-			apiGroup, err := r.client.GetGroup("group/" + groupName)
+			apiGroup, err := r.client.GetGroup(ctx, "group/"+groupName)
 			if err != nil {
 				resp.Diagnostics.AddError("Error Finding Group", fmt.Sprintf("Could not find group '%s' to add user to: %s", groupName, err.Error()))
 				return
@@ -310,6 +352,13 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		fullName = plan.FullName.ValueString()
 	}
 
+	// The write-only password is only set again when its version changes
+	password, diags := configuredPassword(ctx, req.Config, plan.Password, !plan.PasswordWOVersion.Equal(state.PasswordWOVersion))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.ID.ValueString()
 
 	payload := utils.UserPayload{
@@ -319,23 +368,23 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Disable:    plan.Disable.ValueBool(),
 	}
 
-	_, err := r.client.UpdateUser(id, payload)
+	_, err := r.client.UpdateUser(ctx, id, payload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Updating User", fmt.Sprintf("Could not update user with ID %s: %s", id, err.Error()))
 		return
 	}
 
 	// Update password if provided
-	if !plan.Password.IsNull() && !plan.Password.IsUnknown() {
+	if !password.IsNull() && !password.IsUnknown() {
 		uniqueName := "user/" + plan.UserName.ValueString()
-		err := r.client.ChangeUserPassword(uniqueName, plan.Password.ValueString())
+		err := r.client.ChangeUserPassword(ctx, uniqueName, password.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Error Updating User Password", fmt.Sprintf("User was updated but password could not be changed: %s", err.Error()))
 			return
 		}
 	}
 
-	apiUser, err := r.client.GetUser(id)
+	apiUser, err := r.client.GetUser(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Re-reading User After Update", fmt.Sprintf("Could not read user with ID %s after update: %s", id, err.Error()))
 		return
@@ -345,7 +394,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	finalGroupNames := make([]string, 0)
 	for _, groupID := range userData.MemberOf {
-		group, err := r.client.GetGroup(groupID)
+		group, err := r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Could Not Read Member Group", fmt.Sprintf("User is a member of group with ID %s, but it could not be fetched: %s", groupID, err.Error()))
 			continue
@@ -370,7 +419,27 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	resp.Diagnostics.Append(diags...)
 }
 
+// configuredPassword returns the password to set on the user: password_wo from the
+// configuration when rotate is true and it is set, and password otherwise.
+func configuredPassword(ctx context.Context, config tfsdk.Config, password types.String, rotate bool) (types.String, diag.Diagnostics) {
+	if !rotate {
+		return password, nil
+	}
+
+	var passwordWO types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)
+	if diags.HasError() || passwordWO.IsNull() {
+		return password, diags
+	}
+	return passwordWO, diags
+}
+
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state UserResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -378,7 +447,7 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteUser(state.ID.ValueString())
+	err := r.client.DeleteUser(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error Deleting User", fmt.Sprintf("Could not delete user with ID %s: %s", state.ID.ValueString(), err.Error()))
 		return
@@ -389,9 +458,9 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	userName := req.ID
 	apiUniqueName := "user/" + userName
 
-	apiUser, err := r.client.GetUser(apiUniqueName)
+	apiUser, err := r.client.GetUser(ctx, apiUniqueName)
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
+		if utils.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"User Not Found",
 				fmt.Sprintf("Cannot import a user with name '%s' because it does not exist.", userName),
@@ -411,7 +480,7 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	// The API returns group IDs. We must convert them to group names for the state.
 	var groupNames []string
 	for _, groupID := range userData.MemberOf {
-		group, err := r.client.GetGroup(groupID)
+		group, err := r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Could Not Read Member Group on Import", fmt.Sprintf("User is a member of group with ID %s, but it could not be fetched: %s", groupID, err.Error()))
 			continue
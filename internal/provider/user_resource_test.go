@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfiguredPassword(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&UserResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(passwordWO any) tfsdk.Config {
+		attributes := map[string]tftypes.Value{}
+		for name, attrType := range configType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attrType, nil)
+		}
+		attributes["password_wo"] = tftypes.NewValue(tftypes.String, passwordWO)
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, attributes)}
+	}
+
+	tests := []struct {
+		name       string
+		passwordWO any
+		password   types.String
+		rotate     bool
+		want       types.String
+	}{
+		{name: "write-only password", passwordWO: "s3cret-wo", password: types.StringNull(), rotate: true, want: types.StringValue("s3cret-wo")},
+		{name: "unchanged version", passwordWO: "s3cret-wo", password: types.StringNull(), rotate: false, want: types.StringNull()},
+		{name: "password in state", passwordWO: nil, password: types.StringValue("s3cret-state"), rotate: true, want: types.StringValue("s3cret-state")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := configuredPassword(ctx, config(tt.passwordWO), tt.password, tt.rotate)
+			if diags.HasError() {
+				t.Fatalf("configuredPassword() returned errors: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Fatalf("configuredPassword() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &UsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &UsersDataSource{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *utils.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	NamePrefix types.String       `tfsdk:"name_prefix"`
+	MemberOf   types.String       `tfsdk:"member_of"`
+	Users      []UserSummaryModel `tfsdk:"users"`
+}
+
+// UserSummaryModel describes a single user of the list.
+type UserSummaryModel struct {
+	ID         types.String   `tfsdk:"id"`
+	UserName   types.String   `tfsdk:"user_name"`
+	UniqueName types.String   `tfsdk:"unique_name"`
+	FullName   types.String   `tfsdk:"full_name"`
+	Federated  types.Bool     `tfsdk:"federated"`
+	MemberOf   []types.String `tfsdk:"member_of"`
+	Disable    types.Bool     `tfsdk:"disable"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the users of the StorageGrid tenant account, optionally only those with a name prefix or in a group.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list users whose name, without the 'user/' prefix, starts with this prefix.",
+				Optional:    true,
+			},
+			"member_of": schema.StringAttribute{
+				Description: "Only list users that are members of the group with this name, without the 'group/' prefix.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users of the tenant account.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the user, generated by StorageGrid.",
+							Computed:    true,
+						},
+						"user_name": schema.StringAttribute{
+							Description: "The name of the user, without the 'user/' prefix.",
+							Computed:    true,
+						},
+						"unique_name": schema.StringAttribute{
+							Description: "The unique name of the user.",
+							Computed:    true,
+						},
+						"full_name": schema.StringAttribute{
+							Description: "The full name of the user.",
+							Computed:    true,
+						},
+						"federated": schema.BoolAttribute{
+							Description: "Whether the user is synced from the identity source.",
+							Computed:    true,
+						},
+						"member_of": schema.ListAttribute{
+							Description: "The names of the groups the user is a member of. Groups that cannot be resolved to a name, e.g. because they were deleted, are listed by ID and reported in a warning.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"disable": schema.BoolAttribute{
+							Description: "Whether the user account is disabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Users",
+			err.Error(),
+		)
+		return
+	}
+
+	// Users refer to their groups by ID, a single group list resolves all of them
+	groups, err := d.client.ListGroups(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Users",
+			"Could not list the groups to resolve group memberships: "+err.Error(),
+		)
+		return
+	}
+	groupNames := make(map[string]string, len(groups))
+	for _, group := range groups {
+		groupNames[group.ID] = strings.TrimPrefix(group.UniqueName, "group/")
+	}
+
+	// Map API response data to the Terraform state model
+	state.Users = []UserSummaryModel{}
+	for _, user := range users {
+		summary, unresolved := userSummary(user, groupNames)
+		if len(unresolved) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Unresolved Group Memberships",
+				fmt.Sprintf("User %s is a member of groups that could not be resolved to a name, member_of lists them by ID: %s", user.UniqueName, strings.Join(unresolved, ", ")),
+			)
+		}
+		if !strings.HasPrefix(summary.UserName.ValueString(), state.NamePrefix.ValueString()) {
+			continue
+		}
+		if !state.MemberOf.IsNull() && !slices.Contains(summary.MemberOf, state.MemberOf) {
+			continue
+		}
+		state.Users = append(state.Users, summary)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// userSummary converts a user of the list into the Terraform model. Memberships of
+// groups missing from groupNames keep the group ID, and these IDs are also returned.
+func userSummary(user utils.UserData, groupNames map[string]string) (UserSummaryModel, []string) {
+	var unresolved []string
+	memberOf := make([]types.String, 0, len(user.MemberOf))
+	for _, groupID := range user.MemberOf {
+		name, ok := groupNames[groupID]
+		if !ok {
+			name = groupID
+			unresolved = append(unresolved, groupID)
+		}
+		memberOf = append(memberOf, types.StringValue(name))
+	}
+
+	return UserSummaryModel{
+		ID:         types.StringValue(user.ID),
+		UserName:   types.StringValue(strings.TrimPrefix(user.UniqueName, "user/")),
+		UniqueName: types.StringValue(user.UniqueName),
+		FullName:   types.StringValue(user.FullName),
+		Federated:  types.BoolValue(user.Federated),
+		MemberOf:   memberOf,
+		Disable:    types.BoolValue(user.Disable),
+	}, unresolved
+}
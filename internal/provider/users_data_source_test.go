@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
+)
+
+func TestUserSummary(t *testing.T) {
+	user := utils.UserData{
+		ID:         "u1",
+		UniqueName: "user/ci",
+		FullName:   "CI",
+		MemberOf:   []string{"g1", "g2"},
+		Disable:    true,
+	}
+
+	summary, unresolved := userSummary(user, map[string]string{"g1": "developers"})
+	if summary.UserName.ValueString() != "ci" || !summary.Disable.ValueBool() {
+		t.Fatalf("user_name = %s, disable = %s, want ci and true", summary.UserName, summary.Disable)
+	}
+	want := []types.String{types.StringValue("developers"), types.StringValue("g2")}
+	if !slices.Equal(summary.MemberOf, want) {
+		t.Fatalf("member_of = %v, want %v", summary.MemberOf, want)
+	}
+	if !slices.Equal(unresolved, []string{"g2"}) {
+		t.Fatalf("unresolved = %v, want [g2]", unresolved)
+	}
+}
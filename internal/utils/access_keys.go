@@ -5,9 +5,9 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 )
 
@@ -56,9 +56,9 @@ type S3AccessKeyCreatePayload struct {
 }
 
 // GetS3AccessKeys fetches all S3 access keys for a given user.
-func (c *Client) GetS3AccessKeys(userID string) (*S3AccessKeyListAPIResponse, error) {
-	url := fmt.Sprintf("%s/api/v4/org/users/%s/s3-access-keys?includeCloneStatus=false", c.EndpointURL, userID)
-	req, err := http.NewRequest("GET", url, nil)
+func (c *Client) GetS3AccessKeys(ctx context.Context, userID string) (*S3AccessKeyListAPIResponse, error) {
+	url := c.apiURL("/org/users/%s/s3-access-keys?includeCloneStatus=false", userID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -77,16 +77,15 @@ func (c *Client) GetS3AccessKeys(userID string) (*S3AccessKeyListAPIResponse, er
 }
 
 // CreateS3AccessKey creates a new S3 access key for a user.
-func (c *Client) CreateS3AccessKey(userID string, payload S3AccessKeyCreatePayload) (*S3AccessKeyCreateAPIResponse, error) {
+func (c *Client) CreateS3AccessKey(ctx context.Context, userID string, payload S3AccessKeyCreatePayload) (*S3AccessKeyCreateAPIResponse, error) {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling create s3 access key payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/api/v4/org/users/%s/s3-access-keys", c.EndpointURL, userID)
-	log.Printf("Executing POST request to URL: %s", url)
+	url := c.apiURL("/org/users/%s/s3-access-keys", userID)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, err
 	}
@@ -105,20 +104,26 @@ func (c *Client) CreateS3AccessKey(userID string, payload S3AccessKeyCreatePaylo
 }
 
 // DeleteS3AccessKey deletes a specific S3 access key.
-func (c *Client) DeleteS3AccessKey(userID, keyID string) error {
-	url := fmt.Sprintf("%s/api/v4/org/users/%s/s3-access-keys/%s", c.EndpointURL, userID, keyID)
-	log.Printf("Executing DELETE request to URL: %s", url)
+func (c *Client) DeleteS3AccessKey(ctx context.Context, userID, keyID string) error {
+	url := c.apiURL("/org/users/%s/s3-access-keys/%s", userID, keyID)
 
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return fmt.Errorf("error creating DELETE request: %w", err)
-	}
+	// The API returns a 204 No Content on success, so a non-error response is sufficient.
+	return c.deleteAndVerify(ctx, url, func(ctx context.Context) error {
+		return c.getS3AccessKey(ctx, userID, keyID)
+	})
+}
 
-	_, err = c.doRequest(req)
+// getS3AccessKey returns nil if the access key exists or an error matching ErrNotFound
+// if not. The key is looked up in the access keys of the user.
+func (c *Client) getS3AccessKey(ctx context.Context, userID, keyID string) error {
+	keys, err := c.GetS3AccessKeys(ctx, userID)
 	if err != nil {
-		// The API returns a 204 No Content on success, so a non-error response is sufficient.
-		return fmt.Errorf("error executing DELETE request: %w", err)
+		return err
 	}
-
-	return nil
+	for _, key := range keys.Data {
+		if key.ID == keyID {
+			return nil
+		}
+	}
+	return fmt.Errorf("access key %s %w", keyID, ErrNotFound)
 }
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// Tenant account capabilities, which decide the protocol the tenant uses.
+const (
+	CapabilityManagement = "management"
+	CapabilityS3         = "s3"
+	CapabilitySwift      = "swift"
+)
+
+// TenantAccountAPIResponse represents the API response structure for the tenant account.
+type TenantAccountAPIResponse struct {
+	ResponseTime string            `json:"responseTime"`
+	Status       string            `json:"status"`
+	APIVersion   string            `json:"apiVersion"`
+	Deprecated   bool              `json:"deprecated"`
+	Data         TenantAccountData `json:"data"`
+}
+
+// TenantAccountData represents the tenant account the client is signed in to.
+type TenantAccountData struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Capabilities []string `json:"capabilities"`
+}
+
+// HasCapability reports whether the tenant account has the capability, e.g. CapabilitySwift.
+func (a *TenantAccountData) HasCapability(capability string) bool {
+	return slices.Contains(a.Capabilities, capability)
+}
+
+// GetTenantAccount retrieves the tenant account the client is signed in to.
+func (c *Client) GetTenantAccount(ctx context.Context) (*TenantAccountData, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.apiURL("/org/account"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse TenantAccountAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling tenant account response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTenantAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v4/org/account" {
+			t.Errorf("request = %s %s, want GET /api/v4/org/account", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"status": "success",
+			"apiVersion": "4.0",
+			"data": {"id": "12345678901234567890", "name": "swift-tenant", "capabilities": ["management", "swift"]}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	account, err := client.GetTenantAccount(context.Background())
+	if err != nil {
+		t.Fatalf("GetTenantAccount returned error: %v", err)
+	}
+	if account.Name != "swift-tenant" || !account.HasCapability(CapabilitySwift) || account.HasCapability(CapabilityS3) {
+		t.Fatalf("account = %#v, want swift-tenant with the swift capability only", account)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SupportedAPIVersions lists the management API major versions the provider can use,
+// most preferred first. Version 3 is only used for grids that do not offer version 4.
+var SupportedAPIVersions = []int{4, 3}
+
+// negotiateAPIVersion picks the most preferred supported version among the versions
+// offered by the grid.
+func negotiateAPIVersion(available []int) (int, error) {
+	for _, version := range SupportedAPIVersions {
+		if slices.Contains(available, version) {
+			return version, nil
+		}
+	}
+	return 0, fmt.Errorf("the management endpoint supports API versions %v, but the provider requires one of %v", available, SupportedAPIVersions)
+}
+
+// APIVersion returns the management API major version used by the client.
+func (c *Client) APIVersion() int {
+	if c.apiVersion == 0 {
+		return SupportedAPIVersions[0]
+	}
+	return c.apiVersion
+}
+
+// OfferedAPIVersions returns the management API major versions the grid offered when the
+// client was created, nil for clients that did not negotiate a version.
+func (c *Client) OfferedAPIVersions() []int {
+	return c.offeredAPIVersions
+}
+
+// apiURL returns the URL of a management API path, formatted with args, for the
+// negotiated API version.
+func (c *Client) apiURL(format string, args ...any) string {
+	return fmt.Sprintf("%s/api/v%d", c.EndpointURL, c.APIVersion()) + fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		available []int
+		want      int
+		wantErr   bool
+	}{
+		{name: "v4 preferred", available: []int{2, 3, 4}, want: 4},
+		{name: "v3 fallback", available: []int{2, 3}, want: 3},
+		{name: "newer versions only", available: []int{5}, wantErr: true},
+		{name: "none", available: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := negotiateAPIVersion(tt.available)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("negotiateAPIVersion() error = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("negotiateAPIVersion() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientUsesNegotiatedAPIVersion(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/versions":
+			_, _ = w.Write([]byte(`{"status": "success", "data": [2, 3]}`))
+		case "/api/v3/authorize":
+			_, _ = w.Write([]byte(`{"status": "success", "data": "v3-token"}`))
+		default:
+			_, _ = w.Write([]byte(`{"status": "success", "data": {"enabled": true}}`))
+		}
+	}))
+	defer server.Close()
+
+	accountID, username, password := "123", "root", "secret"
+	client, err := NewClient(context.Background(), &server.URL, nil, &accountID, &username, &password, ClientOptions{})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.APIVersion() != 3 {
+		t.Fatalf("APIVersion() = %d, want 3", client.APIVersion())
+	}
+	if _, err := client.GetS3ObjectLockSettings(context.Background()); err != nil {
+		t.Fatalf("GetS3ObjectLockSettings() error = %v", err)
+	}
+
+	want := []string{"/api/versions", "/api/v3/authorize", "/api/v3/org/s3-object-lock"}
+	if len(paths) != len(want) {
+		t.Fatalf("paths = %v, want %v", paths, want)
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Fatalf("paths = %v, want %v", paths, want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// APIs recorded in the audit log.
+const (
+	auditAPIManagement = "management"
+	auditAPIS3         = "s3"
+)
+
+// auditLog appends a JSON line per API request to a file.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// auditRecord is a line of the audit log. Bodies are only recorded as the SHA-256 of
+// their redacted form, see redactBody.
+type auditRecord struct {
+	Time               string `json:"time"`
+	API                string `json:"api"`
+	Method             string `json:"method"`
+	URL                string `json:"url"`
+	Status             int    `json:"status,omitempty"`
+	DurationMS         int64  `json:"duration_ms"`
+	RequestBodySHA256  string `json:"request_body_sha256,omitempty"`
+	ResponseBodySHA256 string `json:"response_body_sha256,omitempty"`
+	Error              string `json:"error,omitempty"`
+}
+
+// openAuditLog opens the audit log at path for appending, creating it if necessary.
+func openAuditLog(path string) (*auditLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLog{file: file}, nil
+}
+
+// write appends record to the audit log. Failures are logged but do not fail the request.
+func (a *auditLog) write(ctx context.Context, record auditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		logWarn(ctx, "Failed to encode audit log record", map[string]any{"error": err.Error()})
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.file.Write(append(line, '\n')); err != nil {
+		logWarn(ctx, "Failed to write audit log record", map[string]any{"path": a.file.Name(), "error": err.Error()})
+	}
+}
+
+// auditManagementRequest records a management API request, its response body and err.
+func (c *Client) auditManagementRequest(req *http.Request, res *http.Response, body []byte, duration time.Duration, err error) {
+	if c.audit == nil {
+		return
+	}
+
+	record := auditRecord{
+		Time:               time.Now().UTC().Format(time.RFC3339Nano),
+		API:                auditAPIManagement,
+		Method:             req.Method,
+		URL:                req.URL.String(),
+		DurationMS:         duration.Milliseconds(),
+		ResponseBodySHA256: redactedBodyHash(body),
+	}
+	if req.GetBody != nil {
+		if reqBody, err := req.GetBody(); err == nil {
+			payload, _ := io.ReadAll(reqBody)
+			record.RequestBodySHA256 = redactedBodyHash(payload)
+		}
+	}
+	if res != nil {
+		record.Status = res.StatusCode
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	c.audit.write(req.Context(), record)
+}
+
+// redactedBodyHash returns the hex encoded SHA-256 of the redacted body, or an empty
+// string for an empty body.
+func redactedBodyHash(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(redactBody(body)))
+	return hex.EncodeToString(sum[:])
+}
+
+// emptyBodySHA256 is the payload hash the S3 client signs for requests without a body.
+var emptyBodySHA256 = func() string {
+	sum := sha256.Sum256(nil)
+	return hex.EncodeToString(sum[:])
+}()
+
+// auditedHTTPClient records the requests of the S3 client in the audit log. S3 bodies
+// are XML without credentials, so the payload hash signed by the SDK is recorded for
+// requests and response bodies, which are streamed, are not hashed.
+type auditedHTTPClient struct {
+	next  aws.HTTPClient
+	audit *auditLog
+}
+
+func (a *auditedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := a.next.Do(req)
+
+	record := auditRecord{
+		Time:       time.Now().UTC().Format(time.RFC3339Nano),
+		API:        auditAPIS3,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if hash := req.Header.Get("X-Amz-Content-Sha256"); len(hash) == sha256.Size*2 && hash != emptyBodySHA256 {
+		record.RequestBodySHA256 = hash
+	}
+	if res != nil {
+		record.Status = res.StatusCode
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	a.audit.write(req.Context(), record)
+
+	return res, err
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDoRequestWritesAuditLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"status":"success","data":{"id":"user-1"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := openAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{EndpointURL: server.URL, HTTPClient: server.Client(), Token: "test-token", audit: audit}
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL+"/api/v4/org/users", bytes.NewBufferString(`{"password":"request-secret"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("doRequest() error = %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "request-secret") {
+		t.Fatalf("audit log contains the request secret:\n%s", data)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 audit log lines, got %d:\n%s", len(lines), data)
+	}
+
+	var record auditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+	want := auditRecord{
+		API:                auditAPIManagement,
+		Method:             http.MethodPost,
+		URL:                server.URL + "/api/v4/org/users",
+		Status:             http.StatusCreated,
+		RequestBodySHA256:  redactedBodyHash([]byte(`{"password":"request-secret"}`)),
+		ResponseBodySHA256: redactedBodyHash([]byte(`{"status":"success","data":{"id":"user-1"}}`)),
+	}
+	record.Time, record.DurationMS = "", 0
+	if record != want {
+		t.Fatalf("audit record = %+v, want %+v", record, want)
+	}
+	if record.RequestBodySHA256 != redactedBodyHash([]byte(`{"password":"other-secret"}`)) {
+		t.Fatal("expected bodies that only differ in secrets to have the same hash")
+	}
+}
@@ -6,6 +6,7 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 type StringOrSlice []string
@@ -25,3 +26,31 @@ func (s *StringOrSlice) UnmarshalJSON(b []byte) error {
 
 	return fmt.Errorf("failed to unmarshal string or slice of strings")
 }
+
+// Principal is the Principal or NotPrincipal element of a policy statement, mapping
+// principal types such as "AWS" to principals. The wildcard "*" is kept as the
+// principal type "*".
+type Principal map[string]StringOrSlice
+
+func (p *Principal) UnmarshalJSON(b []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(b, &wildcard); err == nil {
+		*p = Principal{wildcard: {wildcard}}
+		return nil
+	}
+
+	var principals map[string]StringOrSlice
+	if err := json.Unmarshal(b, &principals); err == nil {
+		*p = principals
+		return nil
+	}
+
+	return fmt.Errorf("failed to unmarshal principal")
+}
+
+func (p Principal) MarshalJSON() ([]byte, error) {
+	if len(p) == 1 && slices.Equal(p["*"], []string{"*"}) {
+		return json.Marshal("*")
+	}
+	return json.Marshal(map[string]StringOrSlice(p))
+}
@@ -62,3 +62,47 @@ func TestStringOrSliceUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestPrincipalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Principal
+	}{
+		{
+			name:  "wildcard",
+			input: `"*"`,
+			want:  Principal{"*": {"*"}},
+		},
+		{
+			name:  "principal types",
+			input: `{"AWS":["arn:aws:iam::111:root","arn:aws:iam::222:root"]}`,
+			want:  Principal{"AWS": {"arn:aws:iam::111:root", "arn:aws:iam::222:root"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Principal
+			if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %#v, want %#v", got, tt.want)
+			}
+
+			out, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(out) != tt.input {
+				t.Fatalf("marshal = %s, want %s", out, tt.input)
+			}
+		})
+	}
+
+	var p Principal
+	if err := json.Unmarshal([]byte(`123`), &p); err == nil {
+		t.Fatal("expected error for non principal value, got nil")
+	}
+}
@@ -5,15 +5,18 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 // Global reference to the active client for cleanup on exit.
@@ -23,26 +26,104 @@ var activeClient *Client
 type Client struct {
 	EndpointURL   string
 	S3EndpointURL string
-	HTTPClient    *http.Client
-	Token         string
-
-	// Cache for bucket list
-	// NOTE: Currently using simple caching without mutex for simplicity.
-	// If concurrent access issues arise (multiple goroutines corrupting cache or causing panics),
-	// add thread safety with sync.RWMutex:
-	//   bucketCacheMux  sync.RWMutex
-	// Then wrap cache reads with bucketCacheMux.RLock()/RUnlock() and
-	// cache writes with bucketCacheMux.Lock()/Unlock() using double-checked locking pattern
-	// to prevent race conditions where multiple goroutines fetch/update cache simultaneously.
-	bucketCache     []S3BucketData
-	bucketCacheTime time.Time
+	// S3Port is the port of the S3 API on the management host, used when S3EndpointURL is
+	// not set. Zero maps the default management port 9443 to the default S3 port 10443.
+	S3Port     int
+	HTTPClient *http.Client
+	Token      string
+
+	// HTTP client for the S3 endpoint when it uses other TLS settings than the
+	// management endpoint, nil when S3 requests use HTTPClient.
+	s3HTTPClient *http.Client
+
+	// Management API major version negotiated with the grid, see APIVersion, and the
+	// versions the grid offered, see OfferedAPIVersions.
+	apiVersion         int
+	offeredAPIVersions []int
+
+	// Credentials used to sign in again when the token expires, and the lock that
+	// guards Token while doing so. Nil when the client was created without credentials.
+	// Requests that find the same token expired share one sign-in through signInGroup.
+	signInBody  *SignInBody
+	authMutex   sync.RWMutex
+	signInGroup singleflight.Group
+	// File the token is cached in between runs, empty when tokens are not cached.
+	tokenCacheFile string
+
+	// Per-request timeouts by operation class, see Timeouts.
+	timeouts Timeouts
+	// Retries of transient errors, see RetryOptions.
+	retry RetryOptions
+	// Token bucket shared by all management API requests, nil when not rate limited.
+	limiter *rate.Limiter
+	// Slots for concurrent management and S3 API requests, nil when not limited.
+	requestSlots *semaphore.Weighted
+	// Audit log of all management and S3 API requests, nil when not enabled.
+	audit *auditLog
+	// Request counts and latencies by endpoint, see LogActiveClientMetrics.
+	metrics *apiMetrics
+	// Log management API requests as curl commands, see ClientOptions.
+	logCurlCommands bool
+	// User-Agent and extra headers of management and S3 API requests, see ClientOptions.
+	userAgent    string
+	extraHeaders map[string]string
+	// Host header of S3 requests, see ClientOptions.
+	s3HostHeader string
+
+	// ReadOnly makes resources refuse to create, update or delete anything.
+	ReadOnly bool
+
+	// DefaultRegion is the region of new buckets that do not set one. Empty uses the
+	// default region of the grid.
+	DefaultRegion string
+
+	// SkipS3ActionValidation turns off the plan-time warnings about policy actions that
+	// StorageGrid does not implement.
+	SkipS3ActionValidation bool
+
+	// MaxAccessKeyLifetime is the provider-level limit on how far in the future access
+	// keys may expire. Zero means access keys are not restricted.
+	MaxAccessKeyLifetime time.Duration
+
+	// Cache of bucket list entries, keyed by bucket name. Entries are invalidated
+	// individually when the bucket or one of its sub-configurations is written.
+	// bucketCacheMux guards bucketCache and bucketCacheGen, which counts invalidations
+	// so that a list fetched before an invalidation does not bring back stale entries.
+	// Concurrent cache misses share a single list request through bucketListGroup.
+	bucketCache     map[string]bucketCacheEntry
+	bucketCacheGen  uint64
+	bucketCacheMux  sync.RWMutex
+	bucketCacheTTL  time.Duration
+	bucketListGroup singleflight.Group
+	// bucketListIncludeExtra holds the fields requested in addition to bucketListIncludeParams.
+	bucketListIncludeExtra []string
+
+	// Regions of the grid, fetched once by GetRegions and guarded by regionsMutex.
+	regions      []string
+	regionsMutex sync.Mutex
 
 	// S3 client cache for lifecycle operations
 	// The client and access key are created once and reused for the entire provider session
-	// Access keys expire after 2 hours and are not explicitly deleted
-	s3Client      *s3.Client
-	s3AccessKey   *s3AccessKey
-	s3ClientMutex sync.Mutex
+	// Temporary access keys are deleted on exit, unless s3AccessKeyStored is set
+	s3Client          *s3.Client
+	s3AccessKey       *s3AccessKey
+	s3AccessKeyStored bool
+	s3ClientMutex     sync.Mutex
+
+	// Lifetime, label and reuse of temporary access keys, see TemporaryKeyOptions.
+	temporaryKey TemporaryKeyOptions
+	// File that reusable temporary access keys are stored in, empty when keys are not reused.
+	temporaryKeyCacheFile string
+
+	// Access key configured by the user and used instead of temporary keys. It is
+	// never deleted by the provider. Nil when temporary keys are used.
+	staticS3Key *s3AccessKey
+}
+
+// bucketCacheEntry holds a cached bucket and the time it was fetched.
+type bucketCacheEntry struct {
+	bucket    S3BucketData
+	fetchedAt time.Time
 }
 
 // s3AccessKey represents temporary access keys for S3 operations.
@@ -50,11 +131,12 @@ type s3AccessKey struct {
 	AccessKey string `json:"accessKey"`
 	SecretKey string `json:"secretAccessKey"` // Fixed: API returns "secretAccessKey" not "secretKey"
 	ID        string `json:"id"`
+	Expires   string `json:"expires,omitempty"`
 }
 
 // SignInBody represents the request body for the authentication request.
 type SignInBody struct {
-	AccountID string `json:"accountId"`
+	AccountID string `json:"accountId,omitempty"` // Empty for grid administrators
 	Username  string `json:"username"`
 	Password  string `json:"password"`
 	Cookie    bool   `json:"cookie"`
@@ -70,21 +152,138 @@ type AuthResponse struct {
 	Token        string `json:"data"`
 }
 
-// NewClient creates and configures a new API client.
-func NewClient(mgmtEndpoint, s3Endpoint *string, accountID, username, password *string) (*Client, error) {
-	c := Client{
-		EndpointURL: *mgmtEndpoint,
-		HTTPClient:  &http.Client{Timeout: 60 * time.Second}, // Increased timeout for bucket operations
+// TLSOptions holds the TLS settings of an endpoint.
+type TLSOptions struct {
+	// CACertPEM holds PEM encoded CA certificates that are trusted in addition to
+	// the system roots.
+	CACertPEM string
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+	// ServerName is sent with SNI and verified against the certificate instead of the
+	// host of the endpoint URL. Empty uses the host.
+	ServerName string
+}
+
+// ClientOptions holds optional settings for the connection to StorageGrid.
+type ClientOptions struct {
+	// CACertPEM holds PEM encoded CA certificates that are trusted in addition to
+	// the system roots, for endpoints signed by an internal CA.
+	CACertPEM string
+	// InsecureSkipVerify disables TLS certificate verification of the endpoints.
+	InsecureSkipVerify bool
+	// S3TLS holds separate TLS settings for the S3 endpoint, for grids where it uses
+	// another certificate chain. Nil uses CACertPEM and InsecureSkipVerify.
+	S3TLS *TLSOptions
+	// S3HostHeader is the Host header of S3 requests, signed in place of the host of the
+	// S3 endpoint. Empty uses the host.
+	S3HostHeader string
+	// Connections tunes the connection pool. Unset values use the defaults.
+	Connections ConnectionOptions
+	// Timeouts holds the per-request timeouts. Unset values default to DefaultRequestTimeout.
+	Timeouts Timeouts
+	// Retry configures retries of transient errors. Unset values use the defaults.
+	Retry RetryOptions
+	// S3AccessKey and S3SecretKey are static S3 credentials. When both are set, S3
+	// operations use them instead of creating temporary access keys.
+	S3AccessKey string
+	S3SecretKey string
+	// TemporaryKey configures the temporary access keys created for S3 operations
+	// when no static S3 credentials are set.
+	TemporaryKey TemporaryKeyOptions
+	// RateLimit limits the rate of management API requests. Unset means unlimited.
+	RateLimit RateLimit
+	// MaxConcurrentRequests limits the number of management and S3 API requests in
+	// flight at the same time. Zero means unlimited.
+	MaxConcurrentRequests int
+	// AuditLogPath is a file that a JSON line per management and S3 API request is
+	// appended to. Empty disables the audit log.
+	AuditLogPath string
+	// BucketCacheTTL is how long bucket list entries are cached. Zero uses
+	// DefaultBucketCacheTTL, BucketCacheSession keeps entries until they are invalidated
+	// and a negative value disables the cache.
+	BucketCacheTTL time.Duration
+	// BucketListInclude adds fields of BucketListIncludeOptions to the bucket list, so
+	// that one list request also serves reads of them.
+	BucketListInclude []string
+	// Token is a pre-issued bearer token. When set, the client does not sign in and the
+	// account ID, username and password are ignored. An expired token is not renewed.
+	Token string
+	// SAMLResponse signs in to the identity provider of a grid that enforces single
+	// sign-on. When set, the client signs in with SSO instead of a username and password.
+	// The token is not renewed when it expires.
+	SAMLResponse SAMLResponseFunc
+	// UserAgent is sent as the User-Agent of management API requests and appended to the
+	// User-Agent of the SDK for S3 requests. Empty uses the default of net/http.
+	UserAgent string
+	// ExtraHeaders are set on every management and S3 API request, for API gateways that
+	// route or audit by header.
+	ExtraHeaders map[string]string
+	// CacheToken keeps the bearer token of a password sign-in in a file that only the
+	// current user can read, and uses it in later runs until it expires instead of
+	// signing in again.
+	CacheToken bool
+	// LogCurlCommands logs every management API request as an equivalent curl command at
+	// debug level, without the bearer token and with the credentials of bodies masked.
+	LogCurlCommands bool
+}
+
+// NewClient creates and configures a new API client for a tenant account.
+func NewClient(ctx context.Context, mgmtEndpoint, s3Endpoint *string, accountID, username, password *string, opts ClientOptions) (*Client, error) {
+	c, err := newClient(ctx, *mgmtEndpoint, opts)
+	if err != nil {
+		return nil, err
 	}
 
 	// Set S3 endpoint if provided
 	if s3Endpoint != nil {
 		c.S3EndpointURL = *s3Endpoint
 	}
+	if opts.S3TLS != nil {
+		c.s3HTTPClient, err = newHTTPClient(*opts.S3TLS, opts.Connections)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 TLS settings: %w", err)
+		}
+	}
+	if opts.S3AccessKey != "" && opts.S3SecretKey != "" {
+		c.staticS3Key = &s3AccessKey{AccessKey: opts.S3AccessKey, SecretKey: opts.S3SecretKey}
+	}
+	c.s3HostHeader = opts.S3HostHeader
+	c.temporaryKey = opts.TemporaryKey
+	if opts.TemporaryKey.Reuse && c.staticS3Key == nil {
+		var account, user string
+		if accountID != nil {
+			account = *accountID
+		}
+		if username != nil {
+			user = *username
+		}
+		c.temporaryKeyCacheFile, err = temporaryKeyCacheFile(*mgmtEndpoint, account, user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to locate the directory for reusable access keys: %w", err)
+		}
+	}
+
+	// Use a pre-issued token as is instead of signing in.
+	if opts.Token != "" {
+		c.Token = opts.Token
+		activeClient = c
+		return c, nil
+	}
+
+	// Sign in through the identity provider of the grid.
+	if opts.SAMLResponse != nil && accountID != nil {
+		token, err := c.SignInSSO(ctx, *accountID, opts.SAMLResponse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign in with SSO: %w", err)
+		}
+		c.Token = token
+		activeClient = c
+		return c, nil
+	}
 
 	// If required parameters are not provided, return the client without authenticating.
 	if username == nil || password == nil || accountID == nil || mgmtEndpoint == nil {
-		return &c, nil
+		return c, nil
 	}
 
 	// Authenticate and store the token
@@ -96,38 +295,139 @@ func NewClient(mgmtEndpoint, s3Endpoint *string, accountID, username, password *
 		CsrfToken: false,
 	}
 
-	ar, err := c.SignIn(authPayload)
+	if opts.CacheToken {
+		c.tokenCacheFile, err = tokenCacheFile(*mgmtEndpoint, *accountID, *username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to locate the directory for cached tokens: %w", err)
+		}
+	}
+
+	token, err := c.signIn(ctx, authPayload, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign in: %w", err)
 	}
 
-	c.Token = ar.Token
+	c.Token = token
+	c.signInBody = &authPayload
 
 	// Store reference to active client for cleanup on exit.
-	activeClient = &c
+	activeClient = c
 
-	return &c, nil
+	return c, nil
+}
+
+// NewGridClient creates an API client signed in as a grid administrator, for the
+// /grid endpoints of the management API. Grid clients do not use S3.
+func NewGridClient(ctx context.Context, mgmtEndpoint, username, password string, opts ClientOptions) (*Client, error) {
+	c, err := newClient(ctx, mgmtEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Grid administrators sign in without an account ID
+	authPayload := SignInBody{
+		Username:  username,
+		Password:  password,
+		Cookie:    true,
+		CsrfToken: false,
+	}
+
+	ar, err := c.SignIn(ctx, authPayload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign in as grid administrator: %w", err)
+	}
+
+	c.Token = ar.Token
+	c.signInBody = &authPayload
+
+	return c, nil
+}
+
+// newClient creates an unauthenticated client and negotiates the API version.
+func newClient(ctx context.Context, mgmtEndpoint string, opts ClientOptions) (*Client, error) {
+	httpClient, err := newHTTPClient(TLSOptions{CACertPEM: opts.CACertPEM, InsecureSkipVerify: opts.InsecureSkipVerify}, opts.Connections)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		EndpointURL: mgmtEndpoint,
+		HTTPClient:  httpClient,
+		timeouts:    opts.Timeouts.withDefaults(),
+		retry:       opts.Retry.withDefaults(),
+		limiter:     opts.RateLimit.newLimiter(),
+
+		requestSlots: newRequestSlots(opts.MaxConcurrentRequests),
+		metrics:      newAPIMetrics(),
+
+		bucketCacheTTL:         opts.BucketCacheTTL,
+		bucketListIncludeExtra: opts.BucketListInclude,
+
+		userAgent:    opts.UserAgent,
+		extraHeaders: opts.ExtraHeaders,
+
+		logCurlCommands: opts.LogCurlCommands,
+	}
+
+	if opts.AuditLogPath != "" {
+		c.audit, err = openAuditLog(opts.AuditLogPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open the audit log: %w", err)
+		}
+	}
+
+	// Negotiate the API version before any request that depends on it
+	versions, err := c.GetAPIVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect management API versions: %w", err)
+	}
+	c.offeredAPIVersions = versions
+	c.apiVersion, err = negotiateAPIVersion(versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// httpDoer returns the HTTP client used for requests, applying the configured timeouts.
+func (c *Client) httpDoer() *deadlineHTTPClient {
+	return &deadlineHTTPClient{client: c.HTTPClient, timeouts: c.timeouts}
+}
+
+// s3HTTPDoer returns the HTTP client used for S3 requests, applying the configured timeouts.
+func (c *Client) s3HTTPDoer() *deadlineHTTPClient {
+	if c.s3HTTPClient == nil {
+		return c.httpDoer()
+	}
+	return &deadlineHTTPClient{client: c.s3HTTPClient, timeouts: c.timeouts}
 }
 
 // CleanupActiveClient cleans up the active client's S3 access key if one exists.
 // This should be called when the provider is shutting down.
 func CleanupActiveClient() {
 	if activeClient != nil {
-		activeClient.cleanupS3AccessKey()
+		activeClient.cleanupS3AccessKey(context.Background())
 	}
 }
 
 // cleanupS3AccessKey cleans up the S3 access key if one exists.
-func (c *Client) cleanupS3AccessKey() {
+func (c *Client) cleanupS3AccessKey(ctx context.Context) {
 	c.s3ClientMutex.Lock()
 	defer c.s3ClientMutex.Unlock()
 
+	// Stored keys are used again by later runs and expire on their own
+	if c.s3AccessKey != nil && c.s3AccessKeyStored {
+		logDebug(ctx, "Keeping temporary access key for later runs", map[string]any{"access_key_id": c.s3AccessKey.ID})
+		return
+	}
+
 	if c.s3AccessKey != nil {
-		log.Printf("Cleaning up temporary access key (ID: %s)", c.s3AccessKey.ID)
-		if err := c.deleteAccessKey(c.s3AccessKey.ID); err != nil {
-			log.Printf("Warning: failed to delete temporary access key: %v", err)
+		logDebug(ctx, "Cleaning up temporary access key", map[string]any{"access_key_id": c.s3AccessKey.ID})
+		if err := c.deleteAccessKey(ctx, c.s3AccessKey.ID); err != nil {
+			logWarn(ctx, "Failed to delete temporary access key", map[string]any{"access_key_id": c.s3AccessKey.ID, "error": err.Error()})
 		} else {
-			log.Printf("Successfully deleted temporary access key (ID: %s)", c.s3AccessKey.ID)
+			logDebug(ctx, "Deleted temporary access key", map[string]any{"access_key_id": c.s3AccessKey.ID})
 		}
 		c.s3AccessKey = nil
 		c.s3Client = nil
@@ -135,7 +435,7 @@ func (c *Client) cleanupS3AccessKey() {
 }
 
 // SignIn handles the authentication process and retrieves a token.
-func (c *Client) SignIn(authPayload SignInBody) (*AuthResponse, error) {
+func (c *Client) SignIn(ctx context.Context, authPayload SignInBody) (*AuthResponse, error) {
 	// Marshal the authentication payload into JSON
 	payloadBytes, err := json.Marshal(authPayload)
 	if err != nil {
@@ -143,7 +443,7 @@ func (c *Client) SignIn(authPayload SignInBody) (*AuthResponse, error) {
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v4/authorize", c.EndpointURL), bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL("/authorize"), bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -152,21 +452,15 @@ func (c *Client) SignIn(authPayload SignInBody) (*AuthResponse, error) {
 	req.Header.Set("accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	// Execute the request
-	res, err := c.HTTPClient.Do(req)
+	// Execute the request. The payload and response hold the credentials and are not logged.
+	logDebug(ctx, "Signing in", map[string]any{"account_id": authPayload.AccountID, "username": authPayload.Username})
+	res, body, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	defer res.Body.Close()
-
-	// Read the response body
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
-	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, newAPIError(res.StatusCode, body)
 	}
 
 	// Unmarshal the response into our AuthResponse struct
@@ -178,25 +472,210 @@ func (c *Client) SignIn(authPayload SignInBody) (*AuthResponse, error) {
 	return &authResponse, nil
 }
 
-// doRequest executes an authenticated API request.
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	// Set the authorization header with the token obtained during sign-in
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+// APIVersionsResponse maps to the JSON response from the versions endpoint.
+type APIVersionsResponse struct {
+	ResponseTime string `json:"responseTime"`
+	Status       string `json:"status"`
+	APIVersion   string `json:"apiVersion"`
+	Data         []int  `json:"data"`
+}
 
-	res, err := c.HTTPClient.Do(req)
+// GetAPIVersions retrieves the major API versions supported by the management endpoint.
+// The endpoint does not require authentication.
+func (c *Client) GetAPIVersions(ctx context.Context) ([]int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/versions", c.EndpointURL), nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("accept", "application/json")
+
+	res, body, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res.StatusCode, body)
+	}
+
+	var versionsResponse APIVersionsResponse
+	if err := json.Unmarshal(body, &versionsResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling versions response: %w", err)
+	}
+
+	return versionsResponse.Data, nil
+}
+
+// ValidateCredentials sends a lightweight authenticated request, for the tenant
+// configuration, to check that the endpoint is reachable and the token is accepted.
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.apiURL("/org/config"), nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("error executing request: %w", err)
+	}
+	return nil
+}
+
+// send executes a single management API request within the rate and concurrency limits,
+// with the User-Agent and extra headers of the client, and returns the response with its
+// body read.
+func (c *Client) send(req *http.Request) (*http.Response, []byte, error) {
+	ctx := req.Context()
+	if err := c.waitForRateLimit(ctx); err != nil {
+		return nil, nil, err
+	}
+	release, err := c.acquireRequestSlot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	c.setRequestHeaders(req)
+	c.logCurlCommand(req)
+	start := time.Now()
+	res, err := c.httpDoer().Do(req)
+	c.metrics.recordCall(metricsEndpoint(req.Method, req.URL.Path), time.Since(start), err != nil || res.StatusCode >= 400)
+	if err != nil {
+		c.auditManagementRequest(req, nil, nil, time.Since(start), err)
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
+	c.auditManagementRequest(req, res, body, time.Since(start), err)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	return res, body, nil
+}
+
+// currentToken returns the bearer token used for API requests.
+func (c *Client) currentToken() string {
+	c.authMutex.RLock()
+	defer c.authMutex.RUnlock()
+	return c.Token
+}
+
+// signIn returns a token for payload, through the token cache when it is enabled.
+// staleToken is a token that was rejected and must not be returned from the cache.
+func (c *Client) signIn(ctx context.Context, payload SignInBody, staleToken string) (string, error) {
+	if c.tokenCacheFile != "" {
+		return c.signInWithTokenCache(ctx, payload, staleToken)
 	}
+	ar, err := c.SignIn(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+	return ar.Token, nil
+}
+
+// reauthenticate signs in again after a request using staleToken was rejected. When
+// another request already replaced the token, the new token is kept without signing in.
+// Concurrent calls for the same stale token share a single sign-in and its result, so
+// a failing sign-in is not repeated by every waiting request.
+func (c *Client) reauthenticate(ctx context.Context, staleToken string) error {
+	// The sign-in is shared by all waiters, so it must not be canceled with the first one
+	ch := c.signInGroup.DoChan(staleToken, func() (any, error) {
+		c.authMutex.Lock()
+		defer c.authMutex.Unlock()
+
+		if c.Token != staleToken {
+			return nil, nil
+		}
+
+		logInfo(ctx, "Bearer token was rejected, signing in again")
+		token, err := c.signIn(context.WithoutCancel(ctx), *c.signInBody, staleToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign in again: %w", err)
+		}
+		c.Token = token
+
+		return nil, nil
+	})
 
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-ch:
+		return res.Err
 	}
+}
+
+// doRequest executes an authenticated API request. Requests that fail with a transient
+// error are retried with exponential backoff, see RetryOptions. When the token has expired,
+// the client signs in again once and replays the request.
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	ctx := req.Context()
+	reauthenticated := false
+
+	for attempt := 1; ; attempt++ {
+		// Set the authorization header with the token obtained during sign-in
+		token := c.currentToken()
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		fields := map[string]any{"method": req.Method, "url": req.URL.String(), "attempt": attempt}
+		logDebug(ctx, "Sending API request", fields)
+		if req.GetBody != nil {
+			if reqBody, err := req.GetBody(); err == nil {
+				payload, _ := io.ReadAll(reqBody)
+				logTrace(ctx, "API request body", map[string]any{"method": req.Method, "url": req.URL.String(), "body": redactBody(payload)})
+			}
+		}
+
+		start := time.Now()
+		res, body, err := c.send(req)
+		if err != nil {
+			return nil, err
+		}
+
+		fields["status"] = res.StatusCode
+		fields["duration_ms"] = time.Since(start).Milliseconds()
+		logDebug(ctx, "Received API response", fields)
+		logTrace(ctx, "API response body", map[string]any{"method": req.Method, "url": req.URL.String(), "body": redactBody(body)})
 
-	return body, nil
+		if res.StatusCode >= 200 && res.StatusCode < 300 {
+			return body, nil
+		}
+
+		// A request body can only be sent again if it can be recreated
+		replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+		if res.StatusCode == http.StatusUnauthorized && c.signInBody != nil && !reauthenticated && replayable {
+			reauthenticated = true
+			if err := c.reauthenticate(ctx, token); err != nil {
+				return nil, fmt.Errorf("%w: %w", newAPIError(res.StatusCode, body), err)
+			}
+			continue
+		}
+
+		if attempt < c.retry.MaxAttempts && replayable && c.retry.retryableStatus(req.Method, res.StatusCode) {
+			delay := c.retry.backoff(attempt, res.Header.Get("Retry-After"))
+			logInfo(ctx, "Retrying API request after transient error", map[string]any{
+				"method": req.Method, "url": req.URL.String(), "status": res.StatusCode,
+				"delay": delay.String(), "attempt": attempt + 1, "max_attempts": c.retry.MaxAttempts,
+			})
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			continue
+		}
+
+		return nil, newAPIError(res.StatusCode, body)
+	}
 }
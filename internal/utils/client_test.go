@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClientTLSOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverCAPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := []struct {
+		name    string
+		opts    TLSOptions
+		wantErr bool
+	}{
+		{name: "untrusted certificate", opts: TLSOptions{}, wantErr: true},
+		{name: "custom CA", opts: TLSOptions{CACertPEM: serverCAPEM}},
+		{name: "insecure skip verify", opts: TLSOptions{InsecureSkipVerify: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient, err := newHTTPClient(tt.opts, ConnectionOptions{})
+			if err != nil {
+				t.Fatalf("newHTTPClient returned error: %v", err)
+			}
+
+			res, err := httpClient.Get(server.URL)
+			if err == nil {
+				res.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GET error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientRejectsInvalidCACert(t *testing.T) {
+	if _, err := newHTTPClient(TLSOptions{CACertPEM: "not a certificate"}, ConnectionOptions{}); err == nil {
+		t.Fatal("expected an error for an invalid CA certificate PEM")
+	}
+}
+
+func TestS3RequestsUseSeparateTLSSettings(t *testing.T) {
+	s3Server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code></Error>`))
+	}))
+	defer s3Server.Close()
+
+	s3CAPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s3Server.Certificate().Raw}))
+	s3HTTPClient, err := newHTTPClient(TLSOptions{CACertPEM: s3CAPEM}, ConnectionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The management client does not trust the certificate of the S3 endpoint
+	client := &Client{
+		EndpointURL:   "https://grid.example.com:9443",
+		S3EndpointURL: s3Server.URL,
+		HTTPClient:    &http.Client{},
+		s3HTTPClient:  s3HTTPClient,
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+	}
+
+	if _, err := client.GetS3BucketLifecycleConfiguration(t.Context(), "my-bucket"); err != nil {
+		t.Fatalf("GetS3BucketLifecycleConfiguration() error = %v", err)
+	}
+}
+
+func TestDoRequestSignsInAgainOnExpiredToken(t *testing.T) {
+	var signIns int
+	var bodies []string
+	rejectAll := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/authorize" {
+			signIns++
+			_, _ = w.Write([]byte(`{"status": "success", "data": "fresh-token"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if rejectAll || r.Header.Get("Authorization") != "Bearer fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "expired-token",
+		signInBody:  &SignInBody{AccountID: "123", Username: "root", Password: "secret"},
+	}
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v4/org/groups", bytes.NewBufferString(`{"name": "group"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("doRequest() error = %v", err)
+	}
+
+	if signIns != 1 {
+		t.Errorf("sign-ins = %d, want 1", signIns)
+	}
+	if client.Token != "fresh-token" {
+		t.Errorf("Token = %q, want %q", client.Token, "fresh-token")
+	}
+	for i, body := range bodies {
+		if body != `{"name": "group"}` {
+			t.Errorf("request %d body = %q, want the original body", i+1, body)
+		}
+	}
+
+	// A token that is rejected right after signing in again is reported, not retried forever.
+	client.Token = "expired-token"
+	rejectAll = true
+	req, err = http.NewRequest(http.MethodGet, server.URL+"/api/v4/org/groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("doRequest() error = nil, want 401 error")
+	}
+	if signIns != 2 {
+		t.Errorf("sign-ins = %d, want 2", signIns)
+	}
+}
+
+func TestDoRequestSharesSignInBetweenConcurrentRequests(t *testing.T) {
+	for _, signInFails := range []bool{false, true} {
+		var signIns atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v4/authorize" {
+				signIns.Add(1)
+				// Keep the sign-in in flight until all requests were rejected
+				time.Sleep(100 * time.Millisecond)
+				if signInFails {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"status": "success", "data": "fresh-token"}`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer fresh-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status": "success"}`))
+		}))
+
+		client := &Client{
+			EndpointURL: server.URL,
+			HTTPClient:  server.Client(),
+			Token:       "expired-token",
+			signInBody:  &SignInBody{AccountID: "123", Username: "root", Password: "secret"},
+		}
+
+		var wg sync.WaitGroup
+		var failures atomic.Int32
+		for range 10 {
+			wg.Go(func() {
+				req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v4/org/groups", nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := client.doRequest(req); err != nil {
+					failures.Add(1)
+				}
+			})
+		}
+		wg.Wait()
+		server.Close()
+
+		if got := signIns.Load(); got != 1 {
+			t.Errorf("sign-in fails %t: sign-ins = %d, want 1", signInFails, got)
+		}
+		wantFailures := int32(0)
+		if signInFails {
+			wantFailures = 10
+		}
+		if got := failures.Load(); got != wantFailures {
+			t.Errorf("sign-in fails %t: failed requests = %d, want %d", signInFails, got, wantFailures)
+		}
+	}
+}
+
+func TestDoRequestWithoutCredentialsDoesNotSignIn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/authorize" {
+			t.Error("unexpected sign-in request")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := &Client{EndpointURL: server.URL, HTTPClient: server.Client(), Token: "test-token"}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v4/org/groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("doRequest() error = nil, want 401 error")
+	}
+}
+
+func TestNewClientWithTokenSkipsSignIn(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/versions" {
+			_, _ = w.Write([]byte(`{"status": "success", "data": [3, 4]}`))
+			return
+		}
+		if r.URL.Path == "/api/v4/authorize" {
+			t.Error("unexpected sign-in request")
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer pre-issued" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer pre-issued")
+		}
+		_, _ = w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	username, password, accountID := "root", "secret", "123"
+	client, err := NewClient(context.Background(), &server.URL, nil, &accountID, &username, &password, ClientOptions{Token: "pre-issued", InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.Token != "pre-issued" {
+		t.Fatalf("Token = %q, want %q", client.Token, "pre-issued")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v4/org/groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("doRequest() error = %v", err)
+	}
+}
+
+func TestNewGridClientSignsInWithoutAccountID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/versions":
+			_, _ = w.Write([]byte(`{"status": "success", "data": [4]}`))
+		case "/api/v4/authorize":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding sign-in body: %v", err)
+			}
+			if _, ok := body["accountId"]; ok {
+				t.Errorf("grid sign-in body has an accountId: %v", body)
+			}
+			_, _ = w.Write([]byte(`{"status": "success", "data": "grid-token"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewGridClient(context.Background(), server.URL, "admin", "secret", ClientOptions{})
+	if err != nil {
+		t.Fatalf("NewGridClient() error = %v", err)
+	}
+	if client.Token != "grid-token" {
+		t.Fatalf("Token = %q, want %q", client.Token, "grid-token")
+	}
+	if activeClient == client {
+		t.Fatal("grid client must not replace the tenant client used for cleanup")
+	}
+}
+
+func TestValidateCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/org/config" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"status": "error", "code": 401, "message": {"text": "Invalid token"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "success", "data": {}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{EndpointURL: server.URL, HTTPClient: server.Client(), Token: "test-token"}
+	if err := client.ValidateCredentials(t.Context()); err != nil {
+		t.Fatalf("ValidateCredentials() error = %v", err)
+	}
+
+	client.Token = "expired-token"
+	var apiErr *APIError
+	if err := client.ValidateCredentials(t.Context()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("ValidateCredentials() error = %v, want a 401 API error", err)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// newRequestSlots returns the semaphore limiting concurrent requests to max, or nil when
+// max is not positive and requests are not limited.
+func newRequestSlots(max int) *semaphore.Weighted {
+	if max <= 0 {
+		return nil
+	}
+	return semaphore.NewWeighted(int64(max))
+}
+
+// acquireRequestSlot blocks until fewer than the configured maximum of requests are in
+// flight, or returns an error when ctx is done first. The returned function releases the slot.
+func (c *Client) acquireRequestSlot(ctx context.Context) (func(), error) {
+	if c.requestSlots == nil {
+		return func() {}, nil
+	}
+	if err := c.requestSlots.Acquire(ctx, 1); err != nil {
+		return nil, err
+	}
+	return func() { c.requestSlots.Release(1) }, nil
+}
+
+// withRequestSlot runs fn while holding a request slot, for S3 operations that do not
+// go through doRequest.
+func (c *Client) withRequestSlot(ctx context.Context, fn func() error) error {
+	release, err := c.acquireRequestSlot(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return fn()
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequestLimitsConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:  server.URL,
+		HTTPClient:   server.Client(),
+		Token:        "test-token",
+		requestSlots: newRequestSlots(2),
+	}
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Go(func() {
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := client.doRequest(req); err != nil {
+				t.Errorf("doRequest() error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got != 2 {
+		t.Fatalf("max concurrent requests = %d, want 2", got)
+	}
+}
+
+func TestWithRequestSlotReleasesSlot(t *testing.T) {
+	client := &Client{requestSlots: newRequestSlots(1)}
+
+	for range 2 {
+		if err := client.withRequestSlot(context.Background(), func() error { return nil }); err != nil {
+			t.Fatalf("withRequestSlot() error = %v", err)
+		}
+	}
+
+	if newRequestSlots(0) != nil {
+		t.Fatal("newRequestSlots(0) should not limit requests")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// curlOmittedHeaders are not included in curl commands, as they hold credentials or are
+// set by curl itself.
+var curlOmittedHeaders = []string{"Authorization", "Cookie", "Content-Length"}
+
+// logCurlCommand logs a management API request as an equivalent curl command at debug
+// level, when the client logs curl commands.
+func (c *Client) logCurlCommand(req *http.Request) {
+	if !c.logCurlCommands {
+		return
+	}
+	logDebug(req.Context(), "Management API request as curl command", map[string]any{"curl": curlCommand(req)})
+}
+
+// curlCommand returns a curl command that sends req. Credentials are left out: the
+// Authorization header is replaced by a placeholder and the body is redacted as for
+// logging, see redactBody.
+func curlCommand(req *http.Request) string {
+	args := []string{"curl", "-X", req.Method, shellQuote(req.URL.String())}
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if slices.Contains(curlOmittedHeaders, http.CanonicalHeaderKey(name)) {
+			continue
+		}
+		for _, value := range req.Header.Values(name) {
+			args = append(args, "-H", shellQuote(name+": "+value))
+		}
+	}
+	if req.Header.Get("Authorization") != "" {
+		args = append(args, "-H", shellQuote("Authorization: Bearer $STORAGEGRID_TOKEN"))
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			payload, _ := io.ReadAll(body)
+			if len(payload) > 0 {
+				args = append(args, "--data-raw", shellQuote(redactBody(payload)))
+			}
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+// shellQuote quotes s for a POSIX shell, which takes everything between single quotes
+// literally.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
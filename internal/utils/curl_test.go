@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+func TestCurlCommand(t *testing.T) {
+	req, err := http.NewRequest("POST", "https://grid.example.com/api/v4/authorize", bytes.NewBufferString(`{"username": "admin", "password": "it's secret"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer real-token")
+	req.Header.Set("X-Team", "o'brien")
+
+	want := `curl -X POST 'https://grid.example.com/api/v4/authorize' -H 'Content-Type: application/json' -H 'X-Team: o'\''brien' ` +
+		`-H 'Authorization: Bearer $STORAGEGRID_TOKEN' --data-raw '{"password":"***","username":"admin"}'`
+	if got := curlCommand(req); got != want {
+		t.Fatalf("curlCommand() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCurlCommandWithoutBody(t *testing.T) {
+	req, err := http.NewRequest("GET", "https://grid.example.com/api/v4/org/containers?include=region", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `curl -X GET 'https://grid.example.com/api/v4/org/containers?include=region'`
+	if got := curlCommand(req); got != want {
+		t.Fatalf("curlCommand() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// deleteVerifyDelay is how long to wait after a timed out DELETE request before checking
+// whether the object was deleted anyway.
+var deleteVerifyDelay = 2 * time.Second
+
+// deleteAndVerify sends a DELETE request for url. An object that does not exist counts
+// as deleted. When the request times out, StorageGrid may still complete the delete, so
+// get is used to look the object up before the timeout is reported: it returns an error
+// matching ErrNotFound once the object is gone.
+func (c *Client) deleteAndVerify(ctx context.Context, url string, get func(context.Context) error) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating DELETE request: %w", err)
+	}
+
+	_, err = c.doRequest(req)
+	if err == nil || IsNotFound(err) {
+		return nil
+	}
+	if !isTimeoutError(err) {
+		return fmt.Errorf("error executing DELETE request: %w", err)
+	}
+
+	logWarn(ctx, "Delete request timed out, checking if the object was deleted anyway", map[string]any{"url": url})
+
+	// Give the delete a moment to complete
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("error executing DELETE request: %w", err)
+	case <-time.After(deleteVerifyDelay):
+	}
+
+	if checkErr := get(ctx); IsNotFound(checkErr) {
+		logInfo(ctx, "Object was deleted despite the timeout", map[string]any{"url": url})
+		return nil
+	}
+	return fmt.Errorf("error executing DELETE request: %w", err)
+}
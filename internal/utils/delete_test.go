@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeleteAndVerify(t *testing.T) {
+	deleteVerifyDelay = 0
+	t.Cleanup(func() { deleteVerifyDelay = 2 * time.Second })
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		getErr  error
+		wantErr bool
+	}{
+		{
+			name:    "deleted",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) },
+		},
+		{
+			name:    "already gone",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNotFound) },
+		},
+		{
+			name:    "forbidden",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusForbidden) },
+			wantErr: true,
+		},
+		{
+			name:    "timed out but deleted",
+			handler: func(w http.ResponseWriter, r *http.Request) { <-r.Context().Done() },
+			getErr:  &APIError{StatusCode: http.StatusNotFound},
+		},
+		{
+			name:    "timed out and still there",
+			handler: func(w http.ResponseWriter, r *http.Request) { <-r.Context().Done() },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			client := &Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+				timeouts:    Timeouts{Delete: 50 * time.Millisecond},
+			}
+
+			err := client.deleteAndVerify(t.Context(), server.URL+"/api/v4/org/groups/g1", func(context.Context) error { return tt.getErr })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("deleteAndVerify() error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
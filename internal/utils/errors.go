@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/smithy-go"
+)
+
+// Errors matched by errors.Is for classes of API errors.
+var (
+	// ErrNotFound is for objects that do not exist, such as a management API response with
+	// status 404.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is for requests that conflict with the current state of an object, such
+	// as a management API response with status 409.
+	ErrConflict = errors.New("conflict")
+	// ErrThrottled is for requests rejected because of their rate, such as a management
+	// API response with status 429.
+	ErrThrottled = errors.New("throttled")
+)
+
+// APIError is returned for management API responses with an unsuccessful status. Code
+// and Message are parsed from the StorageGrid error response, if the body is one.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Body       []byte
+}
+
+// apiErrorResponse maps to the JSON body of management API error responses.
+type apiErrorResponse struct {
+	Message struct {
+		Key  string `json:"key"`
+		Text string `json:"text"`
+	} `json:"message"`
+}
+
+// newAPIError returns the APIError for a response with status and body.
+func newAPIError(status int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: status, Body: body}
+
+	var response apiErrorResponse
+	if err := json.Unmarshal(body, &response); err == nil {
+		apiErr.Code = response.Message.Key
+		apiErr.Message = response.Message.Text
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// Is makes errors.Is match ErrNotFound, ErrConflict and ErrThrottled for responses with
+// status 404, 409 and 429.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrThrottled:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// IsNotFound reports whether err means that the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsBucketNotEmpty reports whether err means that a bucket cannot be deleted because it
+// still holds objects. StorageGrid reports it with a message rather than a status of its
+// own, and the S3 API with the BucketNotEmpty code.
+func IsBucketNotEmpty(err error) bool {
+	if s3ErrorCode(err) == "BucketNotEmpty" {
+		return true
+	}
+	return apiErrorMessageContains(err, "not empty") || apiErrorMessageContains(err, "notempty")
+}
+
+// IsObjectLockVersioningConflict reports whether err means that the versioning of a
+// bucket cannot be changed because the bucket has S3 Object Lock enabled.
+func IsObjectLockVersioningConflict(err error) bool {
+	return apiErrorMessageContains(err, "object lock configuration is present")
+}
+
+// IsObjectLockDisableRejected reports whether err means that S3 Object Lock cannot be
+// disabled on a bucket, which StorageGrid rejects as an invalid ObjectLockEnabled value.
+func IsObjectLockDisableRejected(err error) bool {
+	return apiErrorMessageContains(err, "invalid objectlockenabled value")
+}
+
+// apiErrorMessageContains reports whether err is a management API client error whose code
+// or message contains the lower-case text, ignoring case.
+func apiErrorMessageContains(err error, text string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode < 400 || apiErr.StatusCode >= 500 {
+		return false
+	}
+	return strings.Contains(strings.ToLower(apiErr.Code+" "+apiErr.Message), text)
+}
+
+// s3ErrorCode returns the error code of an S3 error response, such as NoSuchBucket, or
+// an empty string if err is not one.
+func s3ErrorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return ""
+}
+
+// isS3AccessKeyError reports whether err is an S3 error response that means the access
+// key was rejected.
+func isS3AccessKeyError(err error) bool {
+	switch s3ErrorCode(err) {
+	case "AccessDenied", "InvalidAccessKeyId", "TokenRefreshRequired", "ExpiredToken":
+		return true
+	}
+	return false
+}
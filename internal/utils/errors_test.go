@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "404 response", err: &APIError{StatusCode: http.StatusNotFound}, want: true},
+		{name: "wrapped 404 response", err: fmt.Errorf("error executing request: %w", &APIError{StatusCode: http.StatusNotFound}), want: true},
+		{name: "missing bucket", err: fmt.Errorf("bucket logs %w", ErrNotFound), want: true},
+		{name: "other status", err: &APIError{StatusCode: http.StatusForbidden}},
+		{name: "other error", err: errors.New("connection refused")},
+		{name: "nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Fatalf("IsNotFound(%v) = %t, want %t", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{status: http.StatusNotFound, want: ErrNotFound},
+		{status: http.StatusConflict, want: ErrConflict},
+		{status: http.StatusTooManyRequests, want: ErrThrottled},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("error executing request: %w", &APIError{StatusCode: tt.status})
+		for _, target := range []error{ErrNotFound, ErrConflict, ErrThrottled} {
+			if got := errors.Is(err, target); got != (target == tt.want) {
+				t.Errorf("errors.Is(%v, %v) = %t", err, target, got)
+			}
+		}
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	body := []byte(`{"responseTime": "2026-01-01T00:00:00.000Z", "status": "error", "apiVersion": "4.0", "code": 409,
+		"message": {"text": "The group already exists.", "key": "org.group.exists"}}`)
+
+	err := newAPIError(http.StatusConflict, body)
+	if err.Code != "org.group.exists" || err.Message != "The group already exists." {
+		t.Fatalf("newAPIError() = Code %q, Message %q", err.Code, err.Message)
+	}
+	if want := fmt.Sprintf("status: 409, body: %s", body); err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	// Bodies that are not StorageGrid error responses are kept as is
+	err = newAPIError(http.StatusBadGateway, []byte("<html>Bad Gateway</html>"))
+	if err.Code != "" || err.Message != "" || string(err.Body) != "<html>Bad Gateway</html>" {
+		t.Fatalf("newAPIError() = %+v", err)
+	}
+}
+
+func TestIsS3AccessKeyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: fmt.Errorf("operation error S3: ListObjectsV2: %w", &smithy.GenericAPIError{Code: "InvalidAccessKeyId"}), want: true},
+		{err: &smithy.GenericAPIError{Code: "ExpiredToken"}, want: true},
+		{err: &smithy.GenericAPIError{Code: "NoSuchBucket"}},
+		{err: errors.New("AccessDenied")},
+	}
+
+	for _, tt := range tests {
+		if got := isS3AccessKeyError(tt.err); got != tt.want {
+			t.Errorf("isS3AccessKeyError(%v) = %t, want %t", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestIsBucketNotEmpty(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "management API", err: fmt.Errorf("error executing DELETE request: %w", newAPIError(http.StatusUnprocessableEntity, []byte(`{"message": {"text": "Bucket is not empty."}}`))), want: true},
+		{name: "S3 API", err: &smithy.GenericAPIError{Code: "BucketNotEmpty"}, want: true},
+		{name: "other message", err: newAPIError(http.StatusUnprocessableEntity, []byte(`{"message": {"text": "Invalid bucket name."}}`))},
+		{name: "server error", err: newAPIError(http.StatusInternalServerError, []byte(`{"message": {"text": "Bucket is not empty."}}`))},
+		{name: "nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBucketNotEmpty(tt.err); got != tt.want {
+				t.Fatalf("IsBucketNotEmpty(%v) = %t, want %t", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsObjectLockErrors(t *testing.T) {
+	versioningConflict := fmt.Errorf("error executing PUT request: %w", newAPIError(http.StatusBadRequest,
+		[]byte(`{"message": {"key": "ContainerVersioningConflict", "text": "Versioning cannot be changed when an S3 Object Lock configuration is present."}}`)))
+	disableRejected := newAPIError(http.StatusBadRequest, []byte(`{"message": {"text": "Invalid ObjectLockEnabled value"}}`))
+	serverError := newAPIError(http.StatusInternalServerError, []byte(`{"message": {"text": "Invalid ObjectLockEnabled value"}}`))
+
+	tests := []struct {
+		name                string
+		err                 error
+		wantVersioning      bool
+		wantDisableRejected bool
+	}{
+		{name: "versioning conflict", err: versioningConflict, wantVersioning: true},
+		{name: "disable rejected", err: disableRejected, wantDisableRejected: true},
+		{name: "server error", err: serverError},
+		{name: "not an API error", err: errors.New("Invalid ObjectLockEnabled value")},
+		{name: "nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsObjectLockVersioningConflict(tt.err); got != tt.wantVersioning {
+				t.Fatalf("IsObjectLockVersioningConflict(%v) = %t, want %t", tt.err, got, tt.wantVersioning)
+			}
+			if got := IsObjectLockDisableRejected(tt.err); got != tt.wantDisableRejected {
+				t.Fatalf("IsObjectLockDisableRejected(%v) = %t, want %t", tt.err, got, tt.wantDisableRejected)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestListGridAccountsPagesThroughAccounts(t *testing.T) {
+	var all []GridAccountData
+	for i := range 150 {
+		all = append(all, GridAccountData{ID: fmt.Sprintf("%020d", i), Name: fmt.Sprintf("tenant-%03d", i), Capabilities: []string{CapabilityManagement, CapabilityS3}})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/grid/accounts" {
+			t.Errorf("path = %s, want /api/v4/grid/accounts", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		start := 0
+		if marker := r.URL.Query().Get("marker"); marker != "" {
+			start = slices.IndexFunc(all, func(a GridAccountData) bool { return a.ID == marker }) + 1
+		}
+		end := min(start+gridAccountListPageSize, len(all))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(GridAccountListAPIResponse{Status: "success", Data: all[start:end]})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	accounts, err := client.ListGridAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("ListGridAccounts returned error: %v", err)
+	}
+	if len(accounts) != len(all) || accounts[149].Name != "tenant-149" {
+		t.Fatalf("ListGridAccounts returned %d accounts, want %d", len(accounts), len(all))
+	}
+}
+
+func TestUpdateGridAccountProfileKeepsOtherFields(t *testing.T) {
+	var put map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/grid/accounts/42" {
+			t.Errorf("path = %s, want /api/v4/grid/accounts/42", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"id":"42","name":"old","description":"kept",` +
+				`"capabilities":["management","s3"],"policy":{"quotaObjectBytes":9007199254740993,"allowPlatformServices":true}}}`))
+		case http.MethodPut:
+			decoder := json.NewDecoder(r.Body)
+			decoder.UseNumber()
+			if err := decoder.Decode(&put); err != nil {
+				t.Errorf("decoding PUT body: %v", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"id":"42"}}`))
+		default:
+			t.Errorf("method = %s, want GET or PUT", r.Method)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	if err := client.UpdateGridAccountProfile(context.Background(), "42", "analytics", nil); err != nil {
+		t.Fatalf("UpdateGridAccountProfile returned error: %v", err)
+	}
+	if put["name"] != "analytics" || put["description"] != "kept" {
+		t.Fatalf("PUT name = %v, description = %v, want analytics and the current description", put["name"], put["description"])
+	}
+	policy, _ := put["policy"].(map[string]any)
+	if quota := policy["quotaObjectBytes"]; quota != json.Number("9007199254740993") || policy["allowPlatformServices"] != true {
+		t.Fatalf("PUT policy = %v, want the current policy unchanged", policy)
+	}
+
+	description := "Analytics team"
+	if err := client.UpdateGridAccountProfile(context.Background(), "42", "analytics", &description); err != nil {
+		t.Fatalf("UpdateGridAccountProfile returned error: %v", err)
+	}
+	if put["description"] != description {
+		t.Fatalf("PUT description = %v, want %q", put["description"], description)
+	}
+}
+
+func TestGetGridAccountNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status":"error","code":404,"message":{"text":"account not found"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	if _, err := client.GetGridAccount(context.Background(), "42"); !IsNotFound(err) {
+		t.Fatalf("GetGridAccount error = %v, want a not found error", err)
+	}
+}
+
+func TestSetGridAccountFederationConnection(t *testing.T) {
+	connections := `["conn-b"]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"status":"success","data":{"id":"42","name":"analytics","capabilities":["management","s3"],"gridFederationConnections":%s}}`, connections)
+		case http.MethodPut:
+			var put struct {
+				Name                      string          `json:"name"`
+				GridFederationConnections json.RawMessage `json:"gridFederationConnections"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&put); err != nil || put.Name != "analytics" {
+				t.Errorf("PUT body name = %q, err = %v, want the current account", put.Name, err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			connections = string(put.GridFederationConnections)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success","data":{"id":"42"}}`))
+		default:
+			t.Errorf("method = %s, want GET or PUT", r.Method)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	steps := []struct {
+		connectionID string
+		granted      bool
+		want         string
+	}{
+		{"conn-a", true, `["conn-a","conn-b"]`},
+		{"conn-a", true, `["conn-a","conn-b"]`},
+		{"conn-b", false, `["conn-a"]`},
+		{"conn-a", false, `[]`},
+	}
+	for _, step := range steps {
+		if err := client.SetGridAccountFederationConnection(context.Background(), "42", step.connectionID, step.granted); err != nil {
+			t.Fatalf("SetGridAccountFederationConnection(%s, %t) returned error: %v", step.connectionID, step.granted, err)
+		}
+		if connections != step.want {
+			t.Fatalf("after SetGridAccountFederationConnection(%s, %t) connections = %s, want %s", step.connectionID, step.granted, connections, step.want)
+		}
+	}
+}
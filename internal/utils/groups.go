@@ -5,12 +5,17 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
+// groupListPageSize is the number of groups requested per page when listing groups.
+const groupListPageSize = 100
+
 // GroupAPIResponse represents the full API response object.
 type GroupAPIResponse struct {
 	ResponseTime string    `json:"responseTime"`
@@ -19,6 +24,14 @@ type GroupAPIResponse struct {
 	Data         GroupData `json:"data"`
 }
 
+// GroupListAPIResponse represents a page of the group list.
+type GroupListAPIResponse struct {
+	ResponseTime string      `json:"responseTime"`
+	Status       string      `json:"status"`
+	APIVersion   string      `json:"apiVersion"`
+	Data         []GroupData `json:"data"`
+}
+
 // Group represents the detailed information about a single group.
 type GroupData struct {
 	ID                 string   `json:"id"`
@@ -45,12 +58,17 @@ type S3Policy struct {
 }
 
 // Statement defines a single rule within a policy.
+// Principal and NotPrincipal are only used by bucket policies.
 type Statement struct {
-	Sid       string                              `json:"Sid,omitempty"`
-	Effect    string                              `json:"Effect"`
-	Action    StringOrSlice                       `json:"Action"`
-	Resource  StringOrSlice                       `json:"Resource"`
-	Condition map[string]map[string]StringOrSlice `json:"Condition,omitempty"`
+	Sid          string                              `json:"Sid,omitempty"`
+	Effect       string                              `json:"Effect"`
+	Principal    Principal                           `json:"Principal,omitempty"`
+	NotPrincipal Principal                           `json:"NotPrincipal,omitempty"`
+	Action       StringOrSlice                       `json:"Action"`
+	NotAction    StringOrSlice                       `json:"NotAction,omitempty"`
+	Resource     StringOrSlice                       `json:"Resource"`
+	NotResource  StringOrSlice                       `json:"NotResource,omitempty"`
+	Condition    map[string]map[string]StringOrSlice `json:"Condition,omitempty"`
 }
 
 type ManagementPolicy struct {
@@ -62,17 +80,18 @@ type ManagementPolicy struct {
 	ViewAllContainers         bool `json:"viewAllContainers"`
 }
 
+// GroupPayload is the request body that creates or updates a group. Federated groups
+// take their display name from the identity source, so it is empty for them.
 type GroupPayload struct {
 	UniqueName         string   `json:"uniqueName"`
-	DisplayName        string   `json:"displayName"`
+	DisplayName        string   `json:"displayName,omitempty"`
 	ManagementReadOnly bool     `json:"managementReadOnly"`
 	Policies           Policies `json:"policies"`
 }
 
-func (c *Client) GetGroup(id string) (*GroupAPIResponse, error) {
-	url := fmt.Sprintf("%s/api/v4/org/groups/%s", c.EndpointURL, id)
-	log.Printf("%s", url)
-	req, err := http.NewRequest("GET", url, nil)
+func (c *Client) GetGroup(ctx context.Context, id string) (*GroupAPIResponse, error) {
+	url := c.apiURL("/org/groups/%s", id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +100,6 @@ func (c *Client) GetGroup(id string) (*GroupAPIResponse, error) {
 	if err != nil {
 		return nil, err
 	}
-	fmt.Print(string(body))
 
 	group := GroupAPIResponse{}
 	err = json.Unmarshal(body, &group)
@@ -92,16 +110,62 @@ func (c *Client) GetGroup(id string) (*GroupAPIResponse, error) {
 	return &group, nil
 }
 
-func (c *Client) CreateGroup(payload GroupPayload) (*GroupAPIResponse, error) {
+// ListGroups retrieves all groups of the tenant account, paging through the group list.
+// A nil federated lists local and federated groups, otherwise only groups of that kind.
+func (c *Client) ListGroups(ctx context.Context, federated *bool) ([]GroupData, error) {
+	var groups []GroupData
+	marker := ""
+	for {
+		reqUrl, err := url.Parse(c.apiURL("/org/groups"))
+		if err != nil {
+			return nil, fmt.Errorf("error creating request url: %w", err)
+		}
+		queryParams := reqUrl.Query()
+		queryParams.Set("limit", strconv.Itoa(groupListPageSize))
+		if federated != nil {
+			queryParams.Set("type", "local")
+			if *federated {
+				queryParams.Set("type", "federated")
+			}
+		}
+		// The marker is the URN of the last group of the previous page
+		if marker != "" {
+			queryParams.Set("marker", marker)
+		}
+		reqUrl.RawQuery = queryParams.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, "GET", reqUrl.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %w", err)
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var page GroupListAPIResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("error unmarshaling group list response: %w", err)
+		}
+
+		groups = append(groups, page.Data...)
+		if len(page.Data) < groupListPageSize {
+			return groups, nil
+		}
+		marker = page.Data[len(page.Data)-1].GroupURN
+	}
+}
+
+func (c *Client) CreateGroup(ctx context.Context, payload GroupPayload) (*GroupAPIResponse, error) {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling create group payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/api/v4/org/groups", c.EndpointURL)
-	log.Printf("Executing POST request to URL: %s", url)
+	url := c.apiURL("/org/groups")
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, err
 	}
@@ -120,16 +184,15 @@ func (c *Client) CreateGroup(payload GroupPayload) (*GroupAPIResponse, error) {
 	return &createdGroup, nil
 }
 
-func (c *Client) UpdateGroup(id string, payload GroupPayload) (*GroupAPIResponse, error) {
+func (c *Client) UpdateGroup(ctx context.Context, id string, payload GroupPayload) (*GroupAPIResponse, error) {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling update policies payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/api/v4/org/groups/%s", c.EndpointURL, id)
-	log.Printf("Executing PUT request to URL: %s", url)
+	url := c.apiURL("/org/groups/%s", id)
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, err
 	}
@@ -148,19 +211,11 @@ func (c *Client) UpdateGroup(id string, payload GroupPayload) (*GroupAPIResponse
 	return &updatedGroup, nil
 }
 
-func (c *Client) DeleteGroup(id string) error {
-	url := fmt.Sprintf("%s/api/v4/org/groups/%s", c.EndpointURL, id)
-	log.Printf("Executing DELETE request to URL: %s", url)
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return fmt.Errorf("error creating DELETE request: %w", err)
-	}
-
-	_, err = c.doRequest(req)
-	if err != nil {
-		return fmt.Errorf("error executing DELETE request: %w", err)
-	}
+func (c *Client) DeleteGroup(ctx context.Context, id string) error {
+	url := c.apiURL("/org/groups/%s", id)
 
-	return nil
+	return c.deleteAndVerify(ctx, url, func(ctx context.Context) error {
+		_, err := c.GetGroup(ctx, id)
+		return err
+	})
 }
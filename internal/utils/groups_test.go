@@ -4,7 +4,12 @@
 package utils
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"testing"
 )
 
@@ -373,3 +378,42 @@ func TestStatementCondition_RoundTrip(t *testing.T) {
 		t.Error("Prefix values should be preserved after round-trip")
 	}
 }
+
+func TestListGroupsPagesThroughGroups(t *testing.T) {
+	var all []GroupData
+	for i := range 150 {
+		all = append(all, GroupData{ID: fmt.Sprint(i), UniqueName: fmt.Sprintf("group/g%03d", i), GroupURN: fmt.Sprintf("urn:sgws:identity::1:group/g%03d", i)})
+	}
+
+	var kinds []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kinds = append(kinds, r.URL.Query().Get("type"))
+		start := 0
+		if marker := r.URL.Query().Get("marker"); marker != "" {
+			start = slices.IndexFunc(all, func(g GroupData) bool { return g.GroupURN == marker }) + 1
+		}
+		end := min(start+groupListPageSize, len(all))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(GroupListAPIResponse{Status: "success", Data: all[start:end]})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	federated := true
+	groups, err := client.ListGroups(context.Background(), &federated)
+	if err != nil {
+		t.Fatalf("ListGroups returned error: %v", err)
+	}
+	if len(groups) != len(all) || groups[149].ID != "149" {
+		t.Fatalf("ListGroups returned %d groups, want %d", len(groups), len(all))
+	}
+	if !slices.Equal(kinds, []string{"federated", "federated"}) {
+		t.Fatalf("type query parameters = %v, want federated for both pages", kinds)
+	}
+}
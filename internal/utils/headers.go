@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// setRequestHeaders sets the extra headers and the User-Agent of the client on a
+// management API request.
+func (c *Client) setRequestHeaders(req *http.Request) {
+	for name, value := range c.extraHeaders {
+		req.Header.Set(name, value)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+}
+
+// s3APIOptions returns the S3 client middleware that adds the User-Agent products of the
+// client to the User-Agent of the SDK, sets the extra headers and overrides the Host
+// header. All are set before the request is signed.
+func (c *Client) s3APIOptions() []func(*middleware.Stack) error {
+	var options []func(*middleware.Stack) error
+	for _, product := range strings.Fields(c.userAgent) {
+		name, version, _ := strings.Cut(product, "/")
+		options = append(options, awsmiddleware.AddUserAgentKeyValue(name, version))
+	}
+	for name, value := range c.extraHeaders {
+		options = append(options, smithyhttp.SetHeaderValue(name, value))
+	}
+	if c.s3HostHeader != "" {
+		options = append(options, setHostHeader(c.s3HostHeader))
+	}
+	return options
+}
+
+// setHostHeader returns S3 client middleware that sends requests with host as their Host
+// header. The signer signs the Host of the request, so the signature matches.
+func setHostHeader(host string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Build.Add(middleware.BuildMiddlewareFunc("StorageGridHostHeader", func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+			if req, ok := in.Request.(*smithyhttp.Request); ok {
+				req.Host = host
+			}
+			return next.HandleBuild(ctx, in)
+		}), middleware.After)
+	}
+}
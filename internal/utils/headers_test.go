@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRequestsSetUserAgentAndExtraHeaders(t *testing.T) {
+	var mu sync.Mutex
+	headers := map[string]http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api := "s3"
+		if strings.HasPrefix(r.URL.Path, "/api/") {
+			api = "management"
+		}
+		mu.Lock()
+		headers[api] = r.Header.Clone()
+		mu.Unlock()
+
+		if api == "management" {
+			_, _ = w.Write([]byte(`{"status": "success", "data": {"id": "abc"}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code></Error>`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		apiVersion:    4,
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+		userAgent:     "terraform-provider-storagegrid/1.2.3 Terraform/1.9.0",
+		extraHeaders:  map[string]string{"X-Gateway-Route": "storage-team"},
+	}
+
+	if _, err := client.GetGroup(t.Context(), "group/admins"); err != nil {
+		t.Fatalf("GetGroup() error = %v", err)
+	}
+	if _, err := client.GetS3BucketLifecycleConfiguration(t.Context(), "my-bucket"); err != nil {
+		t.Fatalf("GetS3BucketLifecycleConfiguration() error = %v", err)
+	}
+
+	mgmt := headers["management"]
+	if got := mgmt.Get("User-Agent"); got != client.userAgent {
+		t.Errorf("management User-Agent = %q, want %q", got, client.userAgent)
+	}
+	if got := mgmt.Get("X-Gateway-Route"); got != "storage-team" {
+		t.Errorf("management X-Gateway-Route = %q", got)
+	}
+
+	s3 := headers["s3"]
+	if got := s3.Get("User-Agent"); !strings.Contains(got, "terraform-provider-storagegrid/1.2.3 Terraform/1.9.0") {
+		t.Errorf("S3 User-Agent = %q, want the provider products", got)
+	}
+	if got := s3.Get("X-Gateway-Route"); got != "storage-team" {
+		t.Errorf("S3 X-Gateway-Route = %q", got)
+	}
+	if !strings.Contains(s3.Get("Authorization"), "x-gateway-route") {
+		t.Errorf("expected the extra header to be signed, got Authorization %q", s3.Get("Authorization"))
+	}
+}
+
+func TestS3RequestsSetHostHeader(t *testing.T) {
+	var host, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, authorization = r.Host, r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code></Error>`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+		s3HostHeader:  "s3.storagegrid.example.com",
+	}
+
+	if _, err := client.GetS3BucketLifecycleConfiguration(t.Context(), "my-bucket"); err != nil {
+		t.Fatalf("GetS3BucketLifecycleConfiguration() error = %v", err)
+	}
+	if host != "s3.storagegrid.example.com" {
+		t.Errorf("S3 Host = %q, want %q", host, "s3.storagegrid.example.com")
+	}
+	if !strings.Contains(authorization, ";host;") {
+		t.Errorf("expected the Host header to be signed, got Authorization %q", authorization)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem of the API client. Its level can be set separately
+// with the TF_LOG_PROVIDER_STORAGEGRID_API environment variable.
+const logSubsystem = "api"
+
+// sensitiveLogKeys are log fields and JSON body keys whose values are never logged.
+var sensitiveLogKeys = []string{"password", "token", "authorization", "accessKey", "secretAccessKey", "secret_access_key"}
+
+// bearerTokenPattern matches bearer tokens in log messages.
+var bearerTokenPattern = regexp.MustCompile(`Bearer \S+`)
+
+// apiLogContext returns ctx with the API subsystem logger, which masks sensitive values.
+func apiLogContext(ctx context.Context) context.Context {
+	// The offset points log locations at the caller of the log helpers below
+	ctx = tflog.NewSubsystem(ctx, logSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_STORAGEGRID", logSubsystem),
+		tflog.WithAdditionalLocationOffset(1),
+	)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, sensitiveLogKeys...)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, logSubsystem, bearerTokenPattern)
+	return ctx
+}
+
+func logTrace(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemTrace(apiLogContext(ctx), logSubsystem, msg, fields...)
+}
+
+func logDebug(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemDebug(apiLogContext(ctx), logSubsystem, msg, fields...)
+}
+
+func logInfo(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemInfo(apiLogContext(ctx), logSubsystem, msg, fields...)
+}
+
+func logWarn(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemWarn(apiLogContext(ctx), logSubsystem, msg, fields...)
+}
+
+// redactBody returns a JSON body for logging with the values of sensitive keys masked.
+// Bodies that are not JSON are summarized by their size only.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	return string(redacted)
+}
+
+// redactValue masks the values of sensitive keys in a decoded JSON value.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveLogKey(key) {
+				v[key] = "***"
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveLogKey(key string) bool {
+	for _, sensitive := range sensitiveLogKeys {
+		if strings.EqualFold(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
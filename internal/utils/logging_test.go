@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "empty",
+			body: "",
+			want: "",
+		},
+		{
+			name: "nested secrets",
+			body: `{"data":[{"id":"key-1","accessKey":"AKIA","secretAccessKey":"s3cr3t"}],"status":"success"}`,
+			want: `{"data":[{"accessKey":"***","id":"key-1","secretAccessKey":"***"}],"status":"success"}`,
+		},
+		{
+			name: "password",
+			body: `{"username":"root","Password":"hunter2"}`,
+			want: `{"Password":"***","username":"root"}`,
+		},
+		{
+			name: "not json",
+			body: `<LifecycleConfiguration/>`,
+			want: `<25 bytes>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.want {
+				t.Fatalf("redactBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoRequestLogsWithoutSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"success","data":{"id":"key-1","secretAccessKey":"response-secret"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{EndpointURL: server.URL, HTTPClient: server.Client(), Token: "bearer-secret"}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, bytes.NewBufferString(`{"password":"request-secret"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("doRequest() error = %v", err)
+	}
+
+	logs := output.String()
+	if !strings.Contains(logs, "Received API response") {
+		t.Fatalf("expected the response to be logged, got:\n%s", logs)
+	}
+	for _, secret := range []string{"bearer-secret", "request-secret", "response-secret"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs contain %q:\n%s", secret, logs)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+)
+
+// apiMetrics counts the API requests of a client by endpoint, and the bucket cache
+// lookups, for the summary logged when the provider exits.
+type apiMetrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+	cacheHits int
+	cacheMiss int
+}
+
+// endpointMetrics are the counts and latencies of the requests to an endpoint. Every
+// attempt of a retried request counts as a call.
+type endpointMetrics struct {
+	calls  int
+	errors int
+	total  time.Duration
+	max    time.Duration
+}
+
+func newAPIMetrics() *apiMetrics {
+	return &apiMetrics{endpoints: map[string]*endpointMetrics{}}
+}
+
+// recordCall records a request to endpoint that took duration. A nil m records nothing.
+func (m *apiMetrics) recordCall(endpoint string, duration time.Duration, failed bool) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.endpoints[endpoint]
+	if !ok {
+		e = &endpointMetrics{}
+		m.endpoints[endpoint] = e
+	}
+	e.calls++
+	if failed {
+		e.errors++
+	}
+	e.total += duration
+	e.max = max(e.max, duration)
+}
+
+// recordBucketCache records a bucket cache lookup. A nil m records nothing.
+func (m *apiMetrics) recordBucketCache(hit bool) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if hit {
+		m.cacheHits++
+	} else {
+		m.cacheMiss++
+	}
+}
+
+// log logs a summary of the requests at debug level, followed by the metrics of each
+// endpoint, the slowest in total first.
+func (m *apiMetrics) log(ctx context.Context) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.endpoints))
+	var calls, errors int
+	var total time.Duration
+	for name, e := range m.endpoints {
+		names = append(names, name)
+		calls += e.calls
+		errors += e.errors
+		total += e.total
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Or(cmp.Compare(m.endpoints[b].total, m.endpoints[a].total), strings.Compare(a, b))
+	})
+
+	logDebug(ctx, "API request summary", map[string]any{
+		"calls":               calls,
+		"errors":              errors,
+		"total_ms":            total.Milliseconds(),
+		"bucket_cache_hits":   m.cacheHits,
+		"bucket_cache_misses": m.cacheMiss,
+	})
+	for _, name := range names {
+		e := m.endpoints[name]
+		logDebug(ctx, "API endpoint summary", map[string]any{
+			"endpoint": name,
+			"calls":    e.calls,
+			"errors":   e.errors,
+			"total_ms": e.total.Milliseconds(),
+			"avg_ms":   (e.total / time.Duration(e.calls)).Milliseconds(),
+			"max_ms":   e.max.Milliseconds(),
+		})
+	}
+}
+
+// LogActiveClientMetrics logs the request metrics of the active client. It is called
+// when the provider exits, with a context that holds the provider logger.
+func LogActiveClientMetrics(ctx context.Context) {
+	if activeClient != nil {
+		activeClient.metrics.log(ctx)
+	}
+}
+
+// apiVersionPrefix matches the versioned prefix of management API paths.
+var apiVersionPrefix = regexp.MustCompile(`^.*/api/v\d+`)
+
+// metricsCollections are the path segments followed by the ID or name of an object.
+var metricsCollections = []string{"containers", "users", "groups", "s3-access-keys", "endpoints", "accounts"}
+
+// metricsUniqueNamePrefixes start the unique names of users and groups, which take two
+// path segments such as group/admins.
+var metricsUniqueNamePrefixes = []string{"user", "group", "federated-user", "federated-group"}
+
+// metricsEndpoint returns the endpoint of a management API request for the metrics, with
+// bucket names and object IDs replaced, such as "GET /org/containers/{id}/versioning".
+func metricsEndpoint(method, path string) string {
+	segments := strings.Split(strings.TrimPrefix(apiVersionPrefix.ReplaceAllString(path, ""), "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if !slices.Contains(metricsCollections, segments[i]) || segments[i+1] == "current-user" {
+			continue
+		}
+		if i+2 < len(segments) && slices.Contains(metricsUniqueNamePrefixes, segments[i+1]) && (segments[i] == "users" || segments[i] == "groups") {
+			segments = slices.Delete(segments, i+2, i+3)
+		}
+		segments[i+1] = "{id}"
+		i++
+	}
+	return method + " /" + strings.Join(segments, "/")
+}
+
+// meteredHTTPClient records the requests of the S3 client by S3 operation.
+type meteredHTTPClient struct {
+	next    aws.HTTPClient
+	metrics *apiMetrics
+}
+
+func (m *meteredHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := m.next.Do(req)
+	m.metrics.recordCall("S3 "+awsmiddleware.GetOperationName(req.Context()), time.Since(start), err != nil || res.StatusCode >= 400)
+	return res, err
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{"GET", "/api/v4/org/containers", "GET /org/containers"},
+		{"GET", "/api/v4/org/containers/my-bucket/versioning", "GET /org/containers/{id}/versioning"},
+		{"GET", "/api/v4/org/groups/group/admins", "GET /org/groups/{id}"},
+		{"GET", "/api/v4/org/users/federated-user/alice/s3-access-keys", "GET /org/users/{id}/s3-access-keys"},
+		{"DELETE", "/api/v4/org/users/current-user/s3-access-keys/AKIA123", "DELETE /org/users/current-user/s3-access-keys/{id}"},
+		{"PUT", "/api/v4/org/users/1234-5678/change-password", "PUT /org/users/{id}/change-password"},
+		{"POST", "/api/v4/authorize", "POST /authorize"},
+		{"GET", "/api/versions", "GET /api/versions"},
+	}
+
+	for _, tt := range tests {
+		if got := metricsEndpoint(tt.method, tt.path); got != tt.want {
+			t.Errorf("metricsEndpoint(%q, %q) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAPIMetricsRecordCall(t *testing.T) {
+	m := newAPIMetrics()
+	m.recordCall("GET /org/containers", 10*time.Millisecond, false)
+	m.recordCall("GET /org/containers", 30*time.Millisecond, true)
+
+	e := m.endpoints["GET /org/containers"]
+	if e.calls != 2 || e.errors != 1 || e.total != 40*time.Millisecond || e.max != 30*time.Millisecond {
+		t.Fatalf("metrics = %+v", *e)
+	}
+
+	// A client without metrics records nothing
+	var none *apiMetrics
+	none.recordCall("GET /org/containers", time.Millisecond, false)
+	none.recordBucketCache(true)
+	none.log(t.Context())
+}
+
+func TestSendRecordsMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": {"text": "not found"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{EndpointURL: server.URL, HTTPClient: server.Client(), Token: "test-token", metrics: newAPIMetrics()}
+	if _, err := client.GetS3BucketVersioning(t.Context(), "my-bucket"); err == nil {
+		t.Fatal("expected an error for a missing bucket")
+	}
+
+	e, ok := client.metrics.endpoints["GET /org/containers/{id}/versioning"]
+	if !ok {
+		t.Fatalf("no metrics for the versioning endpoint, got %v", client.metrics.endpoints)
+	}
+	if e.calls != 1 || e.errors != 1 {
+		t.Fatalf("metrics = %+v, want 1 call and 1 error", *e)
+	}
+}
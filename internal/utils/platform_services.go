@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// PlatformServiceEndpointListAPIResponse represents the API response structure for platform services endpoints.
+type PlatformServiceEndpointListAPIResponse struct {
+	ResponseTime string                        `json:"responseTime"`
+	Status       string                        `json:"status"`
+	APIVersion   string                        `json:"apiVersion"`
+	Deprecated   bool                          `json:"deprecated"`
+	Data         []PlatformServiceEndpointData `json:"data"`
+}
+
+// PlatformServiceEndpointData represents a platform services endpoint configured for the tenant.
+type PlatformServiceEndpointData struct {
+	ID          string                        `json:"id"`
+	DisplayName string                        `json:"displayName"`
+	EndpointURI string                        `json:"endpointURI"`
+	EndpointURN string                        `json:"endpointURN"`
+	AuthType    string                        `json:"authType"`
+	Error       *PlatformServiceEndpointError `json:"error,omitempty"`
+}
+
+// PlatformServiceEndpointError represents the most recent error reported for an endpoint.
+type PlatformServiceEndpointError struct {
+	Time string `json:"time"`
+	Text string `json:"text"`
+}
+
+// BucketPlatformServicesData describes which platform services are configured for a bucket.
+type BucketPlatformServicesData struct {
+	ReplicationEnabled       bool
+	ReplicationDestinations  []string
+	NotificationEnabled      bool
+	NotificationDestinations []string
+}
+
+// GetPlatformServiceEndpoints retrieves all platform services endpoints of the tenant.
+func (c *Client) GetPlatformServiceEndpoints(ctx context.Context) ([]PlatformServiceEndpointData, error) {
+	url := c.apiURL("/org/endpoints")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse PlatformServiceEndpointListAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling endpoints response: %w", err)
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetS3BucketPlatformServices retrieves the CloudMirror replication and event notification
+// configuration of a bucket through the S3 API.
+func (c *Client) GetS3BucketPlatformServices(ctx context.Context, bucketName string) (*BucketPlatformServicesData, error) {
+	result := &BucketPlatformServicesData{}
+
+	err := c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Getting platform services configuration", map[string]any{"bucket": bucketName})
+
+		replication, err := client.GetBucketReplication(ctx, &s3.GetBucketReplicationInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			// StorageGrid returns this error when no replication configuration exists
+			if s3ErrorCode(err) != "ReplicationConfigurationNotFoundError" {
+				return fmt.Errorf("error getting bucket replication configuration: %w", err)
+			}
+		} else if replication.ReplicationConfiguration != nil {
+			result.ReplicationDestinations = nil
+			for _, rule := range replication.ReplicationConfiguration.Rules {
+				if rule.Destination != nil && rule.Destination.Bucket != nil {
+					result.ReplicationDestinations = append(result.ReplicationDestinations, aws.ToString(rule.Destination.Bucket))
+				}
+			}
+			result.ReplicationEnabled = len(replication.ReplicationConfiguration.Rules) > 0
+		}
+
+		notification, err := client.GetBucketNotificationConfiguration(ctx, &s3.GetBucketNotificationConfigurationInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			return fmt.Errorf("error getting bucket notification configuration: %w", err)
+		}
+
+		result.NotificationDestinations = nil
+		for _, topic := range notification.TopicConfigurations {
+			result.NotificationDestinations = append(result.NotificationDestinations, aws.ToString(topic.TopicArn))
+		}
+		result.NotificationEnabled = len(result.NotificationDestinations) > 0
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPlatformServiceEndpoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("method = %s, want %s", r.Method, http.MethodGet)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path != "/api/v4/org/endpoints" {
+			t.Errorf("path = %s, want /api/v4/org/endpoints", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"status": "success",
+			"data": [
+				{"id": "1", "displayName": "mirror", "endpointURN": "urn:sgws:s3:::mirror", "endpointURI": "https://s3.example.com"},
+				{"id": "2", "displayName": "events", "endpointURN": "arn:aws:sns:us-east-1:123:events",
+				 "error": {"time": "2026-01-01T00:00:00.000Z", "text": "connection refused"}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	endpoints, err := client.GetPlatformServiceEndpoints(context.Background())
+	if err != nil {
+		t.Fatalf("GetPlatformServiceEndpoints returned error: %v", err)
+	}
+	if len(endpoints) != 2 {
+		t.Fatalf("got %d endpoints, want 2", len(endpoints))
+	}
+	if endpoints[0].Error != nil {
+		t.Fatalf("endpoint 0 error = %#v, want nil", endpoints[0].Error)
+	}
+	if endpoints[1].Error == nil || endpoints[1].Error.Text != "connection refused" {
+		t.Fatalf("endpoint 1 error = %#v", endpoints[1].Error)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit configures the client-side token bucket for management API requests.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate. Zero disables rate limiting.
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent at once. Zero defaults to
+	// RequestsPerSecond rounded up.
+	Burst int
+}
+
+// newLimiter returns the token bucket for the settings, or nil when rate limiting is disabled.
+func (r RateLimit) newLimiter() *rate.Limiter {
+	if r.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	burst := r.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(r.RequestsPerSecond))
+	}
+	return rate.NewLimiter(rate.Limit(r.RequestsPerSecond), burst)
+}
+
+// waitForRateLimit blocks until the rate limit allows another management API request,
+// or returns an error when ctx is done first.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.Wait(ctx)
+}
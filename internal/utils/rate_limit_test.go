@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitNewLimiter(t *testing.T) {
+	if limiter := (RateLimit{}).newLimiter(); limiter != nil {
+		t.Fatalf("newLimiter() = %v, want nil for an unset rate", limiter)
+	}
+
+	limiter := RateLimit{RequestsPerSecond: 2.5}.newLimiter()
+	if limiter.Limit() != rate.Limit(2.5) || limiter.Burst() != 3 {
+		t.Fatalf("limit = %v, burst = %d, want 2.5 and 3", limiter.Limit(), limiter.Burst())
+	}
+
+	limiter = RateLimit{RequestsPerSecond: 10, Burst: 1}.newLimiter()
+	if limiter.Burst() != 1 {
+		t.Fatalf("burst = %d, want 1", limiter.Burst())
+	}
+}
+
+func TestDoRequestWaitsForRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+		limiter:     RateLimit{RequestsPerSecond: 20, Burst: 1}.newLimiter(),
+	}
+
+	start := time.Now()
+	for range 3 {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("doRequest() error = %v", err)
+		}
+	}
+	// The first request uses the burst, the other two wait 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("3 requests took %s, want at least 100ms at 20 requests per second", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("doRequest() error = %v, want context.Canceled", err)
+	}
+}
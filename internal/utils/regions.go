@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RegionsAPIResponse represents the API response structure for the regions of the grid.
+type RegionsAPIResponse struct {
+	ResponseTime string   `json:"responseTime"`
+	Status       string   `json:"status"`
+	APIVersion   string   `json:"apiVersion"`
+	Deprecated   bool     `json:"deprecated"`
+	Data         []string `json:"data"`
+}
+
+// GetRegions retrieves the regions the tenant can create buckets in. Regions are only
+// changed by grid administrators, so they are fetched once per provider session.
+func (c *Client) GetRegions(ctx context.Context) ([]string, error) {
+	c.regionsMutex.Lock()
+	defer c.regionsMutex.Unlock()
+
+	if c.regions != nil {
+		return c.regions, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.apiURL("/org/regions"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("accept", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse RegionsAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling regions response: %w", err)
+	}
+
+	c.regions = apiResponse.Data
+	if c.regions == nil {
+		c.regions = []string{}
+	}
+	return c.regions, nil
+}
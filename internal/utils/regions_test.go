@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestGetRegionsIsCached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/v4/org/regions" {
+			t.Errorf("path = %s, want /api/v4/org/regions", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "success", "apiVersion": "4.0", "data": ["us-east-1", "eu-central-1"]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	for i := range 2 {
+		regions, err := client.GetRegions(context.Background())
+		if err != nil {
+			t.Fatalf("GetRegions attempt %d returned error: %v", i+1, err)
+		}
+		if !slices.Equal(regions, []string{"us-east-1", "eu-central-1"}) {
+			t.Fatalf("regions = %v", regions)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("server received %d requests, want 1 cached request", requests)
+	}
+}
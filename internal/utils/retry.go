@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+)
+
+// Defaults for transient error retries.
+const (
+	DefaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = 1 * time.Second
+	defaultRetryMaxDelay    = 30 * time.Second
+)
+
+// defaultRetryStatusCodes are the response statuses retried unless configured otherwise.
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryOptions configures retries of requests that failed with a transient error.
+// A MaxAttempts of one or less disables retries.
+type RetryOptions struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// StatusCodes are the response statuses that are retried. Nil uses 429, 500, 502,
+	// 503 and 504.
+	StatusCodes []int
+}
+
+// withDefaults returns a copy of r with unset values set to their defaults.
+func (r RetryOptions) withDefaults() RetryOptions {
+	if r.MaxAttempts == 0 {
+		r.MaxAttempts = DefaultRetryMaxAttempts
+	}
+	if r.BaseDelay == 0 {
+		r.BaseDelay = defaultRetryBaseDelay
+	}
+	if r.MaxDelay == 0 {
+		r.MaxDelay = defaultRetryMaxDelay
+	}
+	return r
+}
+
+// statusCodes returns the StatusCodes of r, or the default ones when unset.
+func (r RetryOptions) statusCodes() []int {
+	if r.StatusCodes == nil {
+		return defaultRetryStatusCodes
+	}
+	return r.StatusCodes
+}
+
+// backoff returns the delay before the retry that follows the given attempt, starting at 1.
+// A Retry-After header value takes precedence over the exponential backoff with full jitter.
+// The delay never exceeds MaxDelay.
+func (r RetryOptions) backoff(attempt int, retryAfter string) time.Duration {
+	if delay, ok := parseRetryAfter(retryAfter); ok {
+		return min(delay, r.MaxDelay)
+	}
+
+	ceiling := r.MaxDelay
+	if shift := attempt - 1; shift < 30 {
+		ceiling = min(r.BaseDelay<<shift, r.MaxDelay)
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling) + 1
+}
+
+// parseRetryAfter parses a Retry-After header given as seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// retryableStatus reports whether a request that returned status may be retried, which
+// requires status to be one of StatusCodes. Throttling (429) and unavailability (503) mean
+// the request was not processed, so any request is retried. Other statuses are only
+// retried for idempotent methods.
+func (r RetryOptions) retryableStatus(method string, status int) bool {
+	if !slices.Contains(r.statusCodes(), status) {
+		return false
+	}
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// s3Retryer returns the retryer used by the S3 client. It uses the AWS SDK standard
+// retryer, which also retries the StatusCodes of r and applies the backoff of r. The
+// errors the SDK retries by default are retried as well.
+func (r RetryOptions) s3Retryer() aws.Retryer {
+	codes := map[int]struct{}{}
+	for _, code := range r.statusCodes() {
+		codes[code] = struct{}{}
+	}
+	return retry.NewStandard(func(o *retry.StandardOptions) {
+		o.MaxAttempts = max(r.MaxAttempts, 1)
+		o.MaxBackoff = r.MaxDelay
+		o.Backoff = s3Backoff{options: r}
+		o.Retryables = append(o.Retryables, retry.RetryableHTTPStatusCode{Codes: codes})
+	})
+}
+
+// s3Backoff applies RetryOptions.backoff to S3 requests, including the Retry-After header.
+type s3Backoff struct {
+	options RetryOptions
+}
+
+func (b s3Backoff) BackoffDelay(attempt int, err error) (time.Duration, error) {
+	var retryAfter string
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) && respErr.Response != nil {
+		retryAfter = respErr.Response.Header.Get("Retry-After")
+	}
+	return b.options.backoff(attempt, retryAfter), nil
+}
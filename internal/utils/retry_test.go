@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRetriesTransientErrors(t *testing.T) {
+	var attempts int
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+		retry:       RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString(`{"name": "group"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("doRequest returned error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", attempts)
+	}
+	for i, body := range bodies {
+		if body != `{"name": "group"}` {
+			t.Errorf("attempt %d body = %q, want the original body", i+1, body)
+		}
+	}
+}
+
+func TestDoRequestDoesNotRetryNonIdempotentServerErrors(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+		retry:       RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("POST attempts = %d, want 1", attempts)
+	}
+
+	attempts = 0
+	req, err = http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 3 {
+		t.Fatalf("GET attempts = %d, want 3", attempts)
+	}
+}
+
+func TestDoRequestStopsRetryingWhenContextIsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+		retry:       RetryOptions{MaxAttempts: 3, BaseDelay: time.Hour, MaxDelay: time.Hour},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("doRequest() error = %v, want context.Canceled", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	options := RetryOptions{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		ceiling := min(options.BaseDelay<<(attempt-1), options.MaxDelay)
+		if delay := options.backoff(attempt, ""); delay <= 0 || delay > ceiling {
+			t.Errorf("backoff(%d) = %s, want within (0, %s]", attempt, delay, ceiling)
+		}
+	}
+
+	if delay := options.backoff(1, "0"); delay != 0 {
+		t.Errorf("backoff with Retry-After 0 = %s, want 0", delay)
+	}
+	if delay := options.backoff(1, "120"); delay != options.MaxDelay {
+		t.Errorf("backoff with Retry-After 120 = %s, want capped at %s", delay, options.MaxDelay)
+	}
+}
+
+func TestRetryableStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		options RetryOptions
+		method  string
+		status  int
+		want    bool
+	}{
+		{name: "default throttled POST", method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{name: "default server error GET", method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		{name: "default server error POST", method: http.MethodPost, status: http.StatusBadGateway},
+		{name: "default not found", method: http.MethodGet, status: http.StatusNotFound},
+		{name: "custom conflict PUT", options: RetryOptions{StatusCodes: []int{http.StatusConflict}}, method: http.MethodPut, status: http.StatusConflict, want: true},
+		{name: "custom conflict POST", options: RetryOptions{StatusCodes: []int{http.StatusConflict}}, method: http.MethodPost, status: http.StatusConflict},
+		{name: "custom without 503", options: RetryOptions{StatusCodes: []int{http.StatusBadGateway}}, method: http.MethodGet, status: http.StatusServiceUnavailable},
+		{name: "empty list", options: RetryOptions{StatusCodes: []int{}}, method: http.MethodGet, status: http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.retryableStatus(tt.method, tt.status); got != tt.want {
+				t.Fatalf("retryableStatus(%s, %d) = %t, want %t", tt.method, tt.status, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// S3BucketComplianceAPIResponse represents the API response structure for the legacy
+// compliance settings of a bucket.
+type S3BucketComplianceAPIResponse struct {
+	ResponseTime string           `json:"responseTime"`
+	Status       string           `json:"status"`
+	APIVersion   string           `json:"apiVersion"`
+	Deprecated   bool             `json:"deprecated"`
+	Data         ComplianceConfig `json:"data"`
+}
+
+// GetS3BucketCompliance retrieves the legacy compliance settings of a specific S3 bucket.
+func (c *Client) GetS3BucketCompliance(ctx context.Context, bucketName string) (*ComplianceConfig, error) {
+	url := c.apiURL("/org/containers/%s/compliance", bucketName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse S3BucketComplianceAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling S3 bucket compliance response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// UpdateS3BucketCompliance updates the legacy compliance settings of a specific S3
+// bucket. StorageGrid only allows the retention period to be increased.
+func (c *Client) UpdateS3BucketCompliance(ctx context.Context, bucketName string, compliance ComplianceConfig) error {
+	url := c.apiURL("/org/containers/%s/compliance", bucketName)
+
+	requestBody, err := json.Marshal(compliance)
+	if err != nil {
+		return fmt.Errorf("error marshalling bucket compliance update request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return fmt.Errorf("error creating PUT request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("error executing PUT request: %w", err)
+	}
+
+	var apiResponse S3BucketComplianceAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return fmt.Errorf("error unmarshalling bucket compliance update response: %w", err)
+	}
+
+	if apiResponse.Status != "success" {
+		return fmt.Errorf("bucket compliance update failed with status: %s", apiResponse.Status)
+	}
+
+	// Invalidate the cache entry since the bucket configuration changed
+	c.invalidateBucketCache(bucketName)
+
+	return nil
+}
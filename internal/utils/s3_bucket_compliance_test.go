@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestS3BucketCompliance(t *testing.T) {
+	compliance := ComplianceConfig{RetentionPeriodMinutes: 1440}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/org/containers/records/compliance" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&compliance); err != nil {
+				t.Errorf("error decoding request: %v", err)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(S3BucketComplianceAPIResponse{Status: "success", Data: compliance})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	want := ComplianceConfig{AutoDelete: true, LegalHold: true, RetentionPeriodMinutes: 525600}
+	if err := client.UpdateS3BucketCompliance(t.Context(), "records", want); err != nil {
+		t.Fatalf("UpdateS3BucketCompliance() error = %v", err)
+	}
+	if compliance != want {
+		t.Fatalf("UpdateS3BucketCompliance() sent %+v, want %+v", compliance, want)
+	}
+
+	got, err := client.GetS3BucketCompliance(t.Context(), "records")
+	if err != nil {
+		t.Fatalf("GetS3BucketCompliance() error = %v", err)
+	}
+	if *got != want {
+		t.Fatalf("GetS3BucketCompliance() = %+v, want %+v", *got, want)
+	}
+}
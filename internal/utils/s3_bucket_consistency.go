@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DefaultBucketConsistency is the consistency level of buckets created without one.
+const DefaultBucketConsistency = "read-after-new-write"
+
+// BucketConsistencyLevels are the consistency levels a bucket can have, from the
+// strongest to the most available.
+var BucketConsistencyLevels = []string{"all", "strong-global", "strong-site", "read-after-new-write", "available", "balanced"}
+
+// S3BucketConsistencyAPIResponse represents the API response structure for bucket consistency.
+type S3BucketConsistencyAPIResponse struct {
+	ResponseTime string                  `json:"responseTime"`
+	Status       string                  `json:"status"`
+	APIVersion   string                  `json:"apiVersion"`
+	Deprecated   bool                    `json:"deprecated"`
+	Data         S3BucketConsistencyData `json:"data"`
+}
+
+// S3BucketConsistencyData represents the consistency level of an S3 bucket.
+type S3BucketConsistencyData struct {
+	Consistency string `json:"consistency"`
+}
+
+// GetS3BucketConsistency retrieves the consistency level of a specific S3 bucket.
+func (c *Client) GetS3BucketConsistency(ctx context.Context, bucketName string) (*S3BucketConsistencyData, error) {
+	url := c.apiURL("/org/containers/%s/consistency", bucketName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse S3BucketConsistencyAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling S3 bucket consistency response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// UpdateS3BucketConsistency sets the consistency level of a specific S3 bucket.
+func (c *Client) UpdateS3BucketConsistency(ctx context.Context, bucketName, consistency string) error {
+	url := c.apiURL("/org/containers/%s/consistency", bucketName)
+
+	requestBody, err := json.Marshal(S3BucketConsistencyData{Consistency: consistency})
+	if err != nil {
+		return fmt.Errorf("error marshalling bucket consistency update request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return fmt.Errorf("error creating PUT request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("error executing PUT request: %w", err)
+	}
+
+	var apiResponse S3BucketConsistencyAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return fmt.Errorf("error unmarshalling bucket consistency update response: %w", err)
+	}
+
+	if apiResponse.Status != "success" {
+		return fmt.Errorf("bucket consistency update failed with status: %s", apiResponse.Status)
+	}
+
+	return nil
+}
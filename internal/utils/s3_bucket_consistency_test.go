@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestS3BucketConsistency(t *testing.T) {
+	consistency := DefaultBucketConsistency
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/org/containers/ledger/consistency" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status": "error", "code": 404, "message": {"text": "not found"}}`))
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			var payload S3BucketConsistencyData
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("error decoding request: %v", err)
+			}
+			consistency = payload.Consistency
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(S3BucketConsistencyAPIResponse{Status: "success", Data: S3BucketConsistencyData{Consistency: consistency}})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	data, err := client.GetS3BucketConsistency(t.Context(), "ledger")
+	if err != nil {
+		t.Fatalf("GetS3BucketConsistency() error = %v", err)
+	}
+	if data.Consistency != DefaultBucketConsistency {
+		t.Fatalf("GetS3BucketConsistency() = %q, want %q", data.Consistency, DefaultBucketConsistency)
+	}
+
+	if err := client.UpdateS3BucketConsistency(t.Context(), "ledger", "strong-global"); err != nil {
+		t.Fatalf("UpdateS3BucketConsistency() error = %v", err)
+	}
+	if consistency != "strong-global" {
+		t.Fatalf("consistency = %q, want strong-global", consistency)
+	}
+
+	if _, err := client.GetS3BucketConsistency(t.Context(), "missing"); !IsNotFound(err) {
+		t.Fatalf("GetS3BucketConsistency() for a missing bucket error = %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// CORSRule is a cross-origin resource sharing rule of a bucket.
+type CORSRule struct {
+	ID             string
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposeHeaders  []string
+	MaxAgeSeconds  int
+}
+
+// GetS3BucketCORS retrieves the CORS rules of a bucket through the S3 API. A bucket
+// without a CORS configuration returns no rules, and a bucket that does not exist
+// returns an error matching ErrNotFound.
+func (c *Client) GetS3BucketCORS(ctx context.Context, bucketName string) ([]CORSRule, error) {
+	var rules []CORSRule
+
+	err := c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Getting bucket CORS configuration", map[string]any{"bucket": bucketName})
+
+		output, err := client.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			switch s3ErrorCode(err) {
+			case "NoSuchCORSConfiguration":
+				rules = []CORSRule{}
+				return nil
+			case "NoSuchBucket":
+				return fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
+			}
+			return fmt.Errorf("error getting bucket CORS configuration: %w", err)
+		}
+
+		rules = make([]CORSRule, len(output.CORSRules))
+		for i, rule := range output.CORSRules {
+			rules[i] = CORSRule{
+				ID:             aws.ToString(rule.ID),
+				AllowedOrigins: rule.AllowedOrigins,
+				AllowedMethods: rule.AllowedMethods,
+				AllowedHeaders: rule.AllowedHeaders,
+				ExposeHeaders:  rule.ExposeHeaders,
+				MaxAgeSeconds:  int(aws.ToInt32(rule.MaxAgeSeconds)),
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// PutS3BucketCORS sets the CORS rules of a bucket through the S3 API, replacing any
+// existing CORS configuration.
+func (c *Client) PutS3BucketCORS(ctx context.Context, bucketName string, rules []CORSRule) error {
+	corsRules := make([]types.CORSRule, len(rules))
+	for i, rule := range rules {
+		corsRules[i] = types.CORSRule{
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+		}
+		if rule.ID != "" {
+			corsRules[i].ID = aws.String(rule.ID)
+		}
+		if rule.MaxAgeSeconds > 0 {
+			corsRules[i].MaxAgeSeconds = aws.Int32(int32(rule.MaxAgeSeconds))
+		}
+	}
+
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Setting bucket CORS configuration", map[string]any{"bucket": bucketName, "rules": len(rules)})
+
+		_, err := client.PutBucketCors(ctx, &s3.PutBucketCorsInput{
+			Bucket:            aws.String(bucketName),
+			CORSConfiguration: &types.CORSConfiguration{CORSRules: corsRules},
+		})
+		if err != nil {
+			return fmt.Errorf("error setting bucket CORS configuration: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteS3BucketCORS removes the CORS configuration of a bucket through the S3 API. A
+// bucket without a CORS configuration, or that does not exist, counts as done.
+func (c *Client) DeleteS3BucketCORS(ctx context.Context, bucketName string) error {
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Deleting bucket CORS configuration", map[string]any{"bucket": bucketName})
+
+		_, err := client.DeleteBucketCors(ctx, &s3.DeleteBucketCorsInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			switch s3ErrorCode(err) {
+			case "NoSuchCORSConfiguration", "NoSuchBucket":
+				return nil
+			}
+			return fmt.Errorf("error deleting bucket CORS configuration: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestS3BucketCORS(t *testing.T) {
+	client, server := newInMemoryS3SubresourceServer(t, "assets", "cors", "NoSuchCORSConfiguration")
+
+	rules, err := client.GetS3BucketCORS(t.Context(), "assets")
+	if err != nil || len(rules) != 0 {
+		t.Fatalf("GetS3BucketCORS() = %v, %v, want no rules", rules, err)
+	}
+
+	want := []CORSRule{{
+		ID:             "web",
+		AllowedOrigins: []string{"https://example.com"},
+		AllowedMethods: []string{"GET", "HEAD"},
+		AllowedHeaders: []string{"*"},
+		ExposeHeaders:  []string{"ETag"},
+		MaxAgeSeconds:  3000,
+	}}
+	if err := client.PutS3BucketCORS(t.Context(), "assets", want); err != nil {
+		t.Fatalf("PutS3BucketCORS() error = %v", err)
+	}
+	if !strings.Contains(server.document(), "<AllowedOrigin>https://example.com</AllowedOrigin>") {
+		t.Fatalf("PutS3BucketCORS() sent %s", server.document())
+	}
+
+	// The request body is a CORSConfiguration document, which is also the response of
+	// GetBucketCors
+	rules, err = client.GetS3BucketCORS(t.Context(), "assets")
+	if err != nil {
+		t.Fatalf("GetS3BucketCORS() error = %v", err)
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Fatalf("GetS3BucketCORS() = %+v, want %+v", rules, want)
+	}
+
+	if err := client.DeleteS3BucketCORS(t.Context(), "assets"); err != nil {
+		t.Fatalf("DeleteS3BucketCORS() error = %v", err)
+	}
+	if rules, err := client.GetS3BucketCORS(t.Context(), "assets"); err != nil || len(rules) != 0 {
+		t.Fatalf("GetS3BucketCORS() after delete = %v, %v, want no rules", rules, err)
+	}
+}
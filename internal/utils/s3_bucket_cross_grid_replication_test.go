@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestS3BucketCrossGridReplication(t *testing.T) {
+	var stored []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/org/containers/mirror/cross-grid-replication" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			stored, _ = io.ReadAll(r.Body)
+		}
+
+		var config CrossGridReplicationConfig
+		if len(stored) > 0 {
+			_ = json.Unmarshal(stored, &config)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(S3BucketCrossGridReplicationAPIResponse{Status: "success", Data: config})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	want := []CrossGridReplicationRule{{Grid: "b4e9f7a2-0c1d-4e5f-8a9b-1c2d3e4f5a6b"}}
+	if err := client.UpdateS3BucketCrossGridReplication(t.Context(), "mirror", want); err != nil {
+		t.Fatalf("UpdateS3BucketCrossGridReplication() error = %v", err)
+	}
+	if got := string(stored); got != `{"rules":[{"grid":"b4e9f7a2-0c1d-4e5f-8a9b-1c2d3e4f5a6b"}]}` {
+		t.Fatalf("UpdateS3BucketCrossGridReplication() sent %s", got)
+	}
+
+	config, err := client.GetS3BucketCrossGridReplication(t.Context(), "mirror")
+	if err != nil {
+		t.Fatalf("GetS3BucketCrossGridReplication() error = %v", err)
+	}
+	if !reflect.DeepEqual(config.Rules, want) {
+		t.Fatalf("GetS3BucketCrossGridReplication() = %+v, want %+v", config.Rules, want)
+	}
+
+	// Stopping the replication sends an empty rule list rather than null
+	if err := client.UpdateS3BucketCrossGridReplication(t.Context(), "mirror", nil); err != nil {
+		t.Fatalf("UpdateS3BucketCrossGridReplication() without rules error = %v", err)
+	}
+	if got := string(stored); got != `{"rules":[]}` {
+		t.Fatalf("UpdateS3BucketCrossGridReplication() without rules sent %s", got)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// bucketDeleteStatusPollInterval is how often the delete status of a bucket is checked
+// while StorageGrid deletes its objects.
+var bucketDeleteStatusPollInterval = 10 * time.Second
+
+// GetS3BucketDeleteStatus retrieves the status of the background deletion of the objects
+// of a bucket. It is nil when StorageGrid reports no status for the bucket. The bucket
+// cache is bypassed, since the status changes while the objects are deleted.
+func (c *Client) GetS3BucketDeleteStatus(ctx context.Context, bucketName string) (*DeleteObjectStatusConfig, error) {
+	reqUrl, err := url.Parse(c.apiURL("/org/containers"))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request url: %w", err)
+	}
+	queryParams := reqUrl.Query()
+	queryParams.Add("include", "deleteObjectStatus")
+	reqUrl.RawQuery = queryParams.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqUrl.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("accept", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse S3BucketAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling S3 bucket response: %w", err)
+	}
+
+	for _, bucket := range apiResponse.Data {
+		if bucket.Name == bucketName {
+			return bucket.DeleteStatus, nil
+		}
+	}
+	return nil, fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
+}
+
+// WaitForS3BucketObjectDeletion waits until StorageGrid no longer deletes the objects of
+// a bucket in the background, as buckets cannot be deleted until then. It fails once
+// timeout has passed, and returns an error matching ErrNotFound when the bucket is gone.
+func (c *Client) WaitForS3BucketObjectDeletion(ctx context.Context, bucketName string, timeout time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The deadline of ctx, such as the timeout of the operation, may pass before timeout
+	timedOut := func() error {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("stopped waiting for the objects of bucket %s to be deleted: %w", bucketName, err)
+		}
+		return fmt.Errorf("objects of bucket %s are still being deleted after %s", bucketName, timeout)
+	}
+
+	for {
+		status, err := c.GetS3BucketDeleteStatus(waitCtx, bucketName)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return timedOut()
+			}
+			return err
+		}
+		if status == nil || !status.IsDeletingObjects {
+			return nil
+		}
+
+		logInfo(ctx, "Waiting for the objects of the bucket to be deleted", map[string]any{
+			"bucket":               bucketName,
+			"initial_object_count": status.InitialObjectCount,
+			"initial_object_bytes": status.InitialObjectBytes,
+		})
+
+		select {
+		case <-waitCtx.Done():
+			return timedOut()
+		case <-time.After(bucketDeleteStatusPollInterval):
+		}
+	}
+}
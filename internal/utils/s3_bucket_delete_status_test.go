@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForS3BucketObjectDeletion(t *testing.T) {
+	bucketDeleteStatusPollInterval = time.Millisecond
+	t.Cleanup(func() { bucketDeleteStatusPollInterval = 10 * time.Second })
+
+	tests := []struct {
+		name         string
+		deletingFor  int32
+		timeout      time.Duration
+		listed       bool
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{name: "not deleting objects", listed: true, timeout: time.Second},
+		{name: "deletion completes", deletingFor: 3, listed: true, timeout: time.Second},
+		{name: "deletion times out", deletingFor: 1 << 30, listed: true, timeout: 20 * time.Millisecond, wantErr: true},
+		{name: "bucket gone", timeout: time.Second, wantErr: true, wantNotFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("include"); got != "deleteObjectStatus" {
+					t.Errorf("include query = %q, want deleteObjectStatus", got)
+				}
+				deleting := requests.Add(1) <= tt.deletingFor
+
+				w.Header().Set("Content-Type", "application/json")
+				if !tt.listed {
+					_, _ = w.Write([]byte(`{"status": "success", "data": []}`))
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"status": "success", "data": [{"name": "logs", "deleteObjectStatus": {"isDeletingObjects": %t, "initialObjectCount": "10", "initialObjectBytes": "1024"}}]}`, deleting)
+			}))
+			defer server.Close()
+
+			client := &Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+			}
+
+			err := client.WaitForS3BucketObjectDeletion(context.Background(), "logs", tt.timeout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitForS3BucketObjectDeletion() error = %v, want error %v", err, tt.wantErr)
+			}
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Fatalf("WaitForS3BucketObjectDeletion() error = %v, want not found %v", err, tt.wantNotFound)
+			}
+			if !tt.wantErr && tt.deletingFor > 0 && requests.Load() != tt.deletingFor+1 {
+				t.Fatalf("server received %d requests, want %d", requests.Load(), tt.deletingFor+1)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// BucketNotificationEvents are the event types StorageGrid can send notifications for.
+var BucketNotificationEvents = []string{
+	"s3:ObjectCreated:*",
+	"s3:ObjectCreated:Put",
+	"s3:ObjectCreated:Post",
+	"s3:ObjectCreated:Copy",
+	"s3:ObjectCreated:CompleteMultipartUpload",
+	"s3:ObjectRemoved:*",
+	"s3:ObjectRemoved:Delete",
+	"s3:ObjectRemoved:DeleteMarkerCreated",
+	"s3:ObjectRestore:Post",
+}
+
+// BucketNotificationTopic sends notifications for events of a bucket to a platform
+// services endpoint, identified by its URN.
+type BucketNotificationTopic struct {
+	ID       string
+	TopicURN string
+	Events   []string
+	Prefix   string
+	Suffix   string
+}
+
+// GetS3BucketNotification retrieves the notification topics of a bucket through the S3
+// API. A bucket that does not exist returns an error matching ErrNotFound.
+func (c *Client) GetS3BucketNotification(ctx context.Context, bucketName string) ([]BucketNotificationTopic, error) {
+	var topics []BucketNotificationTopic
+
+	err := c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Getting bucket notification configuration", map[string]any{"bucket": bucketName})
+
+		output, err := client.GetBucketNotificationConfiguration(ctx, &s3.GetBucketNotificationConfigurationInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			if s3ErrorCode(err) == "NoSuchBucket" {
+				return fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
+			}
+			return fmt.Errorf("error getting bucket notification configuration: %w", err)
+		}
+
+		topics = make([]BucketNotificationTopic, len(output.TopicConfigurations))
+		for i, config := range output.TopicConfigurations {
+			topic := BucketNotificationTopic{
+				ID:       aws.ToString(config.Id),
+				TopicURN: aws.ToString(config.TopicArn),
+			}
+			for _, event := range config.Events {
+				topic.Events = append(topic.Events, string(event))
+			}
+			if config.Filter != nil && config.Filter.Key != nil {
+				for _, rule := range config.Filter.Key.FilterRules {
+					// StorageGrid may return the rule names capitalized
+					switch strings.ToLower(string(rule.Name)) {
+					case string(types.FilterRuleNamePrefix):
+						topic.Prefix = aws.ToString(rule.Value)
+					case string(types.FilterRuleNameSuffix):
+						topic.Suffix = aws.ToString(rule.Value)
+					}
+				}
+			}
+			topics[i] = topic
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
+
+// PutS3BucketNotification sets the notification topics of a bucket through the S3 API,
+// replacing the existing notification configuration. No topics disable the notifications
+// of the bucket.
+func (c *Client) PutS3BucketNotification(ctx context.Context, bucketName string, topics []BucketNotificationTopic) error {
+	topicConfigs := make([]types.TopicConfiguration, len(topics))
+	for i, topic := range topics {
+		config := types.TopicConfiguration{
+			TopicArn: aws.String(topic.TopicURN),
+		}
+		if topic.ID != "" {
+			config.Id = aws.String(topic.ID)
+		}
+		for _, event := range topic.Events {
+			config.Events = append(config.Events, types.Event(event))
+		}
+
+		var rules []types.FilterRule
+		if topic.Prefix != "" {
+			rules = append(rules, types.FilterRule{Name: types.FilterRuleNamePrefix, Value: aws.String(topic.Prefix)})
+		}
+		if topic.Suffix != "" {
+			rules = append(rules, types.FilterRule{Name: types.FilterRuleNameSuffix, Value: aws.String(topic.Suffix)})
+		}
+		if len(rules) > 0 {
+			config.Filter = &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: rules}}
+		}
+		topicConfigs[i] = config
+	}
+
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Setting bucket notification configuration", map[string]any{"bucket": bucketName, "topics": len(topics)})
+
+		_, err := client.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+			Bucket:                    aws.String(bucketName),
+			NotificationConfiguration: &types.NotificationConfiguration{TopicConfigurations: topicConfigs},
+		})
+		if err != nil {
+			if s3ErrorCode(err) == "NoSuchBucket" {
+				return fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
+			}
+			return fmt.Errorf("error setting bucket notification configuration: %w", err)
+		}
+
+		return nil
+	})
+}
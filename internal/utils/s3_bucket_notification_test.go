@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestS3BucketNotification(t *testing.T) {
+	var mu sync.Mutex
+	stored := `<NotificationConfiguration></NotificationConfiguration>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchBucket</Code></Error>`))
+			return
+		}
+		if r.URL.Path != "/events" || !r.URL.Query().Has("notification") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			stored = string(body)
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(stored))
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+	}
+
+	topics, err := client.GetS3BucketNotification(t.Context(), "events")
+	if err != nil || len(topics) != 0 {
+		t.Fatalf("GetS3BucketNotification() = %v, %v, want no topics", topics, err)
+	}
+
+	want := []BucketNotificationTopic{
+		{
+			ID:       "uploads",
+			TopicURN: "urn:sgws:sns:us-east-1:uploads",
+			Events:   []string{"s3:ObjectCreated:*"},
+			Prefix:   "incoming/",
+			Suffix:   ".csv",
+		},
+		{
+			ID:       "deletes",
+			TopicURN: "urn:sgws:sns:us-east-1:deletes",
+			Events:   []string{"s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated"},
+		},
+	}
+	if err := client.PutS3BucketNotification(t.Context(), "events", want); err != nil {
+		t.Fatalf("PutS3BucketNotification() error = %v", err)
+	}
+	if !strings.Contains(stored, "<Topic>urn:sgws:sns:us-east-1:uploads</Topic>") {
+		t.Fatalf("PutS3BucketNotification() sent %s", stored)
+	}
+
+	// StorageGrid returns the filter rule names capitalized
+	stored = strings.ReplaceAll(stored, "<Name>prefix</Name>", "<Name>Prefix</Name>")
+	topics, err = client.GetS3BucketNotification(t.Context(), "events")
+	if err != nil {
+		t.Fatalf("GetS3BucketNotification() error = %v", err)
+	}
+	if !reflect.DeepEqual(topics, want) {
+		t.Fatalf("GetS3BucketNotification() = %+v, want %+v", topics, want)
+	}
+
+	if _, err := client.GetS3BucketNotification(t.Context(), "missing"); !IsNotFound(err) {
+		t.Fatalf("GetS3BucketNotification() for a missing bucket error = %v, want a not found error", err)
+	}
+}
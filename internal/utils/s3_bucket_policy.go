@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// GetS3BucketPolicy retrieves the policy document of a bucket through the S3 API. A
+// bucket without a policy, or that does not exist, returns an error matching ErrNotFound.
+func (c *Client) GetS3BucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	var policy string
+
+	err := c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Getting bucket policy", map[string]any{"bucket": bucketName})
+
+		output, err := client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			switch s3ErrorCode(err) {
+			case "NoSuchBucketPolicy":
+				return fmt.Errorf("bucket %s has no policy: %w", bucketName, ErrNotFound)
+			case "NoSuchBucket":
+				return fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
+			}
+			return fmt.Errorf("error getting bucket policy: %w", err)
+		}
+
+		policy = aws.ToString(output.Policy)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return policy, nil
+}
+
+// PutS3BucketPolicy sets the policy document of a bucket through the S3 API, replacing
+// any existing policy.
+func (c *Client) PutS3BucketPolicy(ctx context.Context, bucketName, policy string) error {
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Setting bucket policy", map[string]any{"bucket": bucketName})
+
+		_, err := client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+			Policy: aws.String(policy),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting bucket policy: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteS3BucketPolicy removes the policy of a bucket through the S3 API. A bucket
+// without a policy, or that does not exist, counts as done.
+func (c *Client) DeleteS3BucketPolicy(ctx context.Context, bucketName string) error {
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Deleting bucket policy", map[string]any{"bucket": bucketName})
+
+		_, err := client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			switch s3ErrorCode(err) {
+			case "NoSuchBucketPolicy", "NoSuchBucket":
+				return nil
+			}
+			return fmt.Errorf("error deleting bucket policy: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import "testing"
+
+func TestS3BucketPolicy(t *testing.T) {
+	const document = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"urn:sgws:s3:::logs/*"}]}`
+
+	client, _ := newInMemoryS3SubresourceServer(t, "logs", "policy", "NoSuchBucketPolicy")
+
+	if _, err := client.GetS3BucketPolicy(t.Context(), "logs"); !IsNotFound(err) {
+		t.Fatalf("GetS3BucketPolicy() error = %v, want a not found error", err)
+	}
+
+	if err := client.PutS3BucketPolicy(t.Context(), "logs", document); err != nil {
+		t.Fatalf("PutS3BucketPolicy() error = %v", err)
+	}
+	policy, err := client.GetS3BucketPolicy(t.Context(), "logs")
+	if err != nil {
+		t.Fatalf("GetS3BucketPolicy() error = %v", err)
+	}
+	if policy != document {
+		t.Fatalf("GetS3BucketPolicy() = %s, want %s", policy, document)
+	}
+
+	if err := client.DeleteS3BucketPolicy(t.Context(), "logs"); err != nil {
+		t.Fatalf("DeleteS3BucketPolicy() error = %v", err)
+	}
+	if _, err := client.GetS3BucketPolicy(t.Context(), "logs"); !IsNotFound(err) {
+		t.Fatalf("GetS3BucketPolicy() after delete error = %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// GetS3BucketTagging retrieves the tags of a bucket through the S3 API. A bucket
+// without tags returns an empty map, and a bucket that does not exist returns an error
+// matching ErrNotFound.
+func (c *Client) GetS3BucketTagging(ctx context.Context, bucketName string) (map[string]string, error) {
+	tags := map[string]string{}
+
+	err := c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Getting bucket tagging", map[string]any{"bucket": bucketName})
+
+		output, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			switch s3ErrorCode(err) {
+			case "NoSuchTagSet", "NoSuchTagSetError":
+				return nil
+			case "NoSuchBucket":
+				return fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
+			}
+			return fmt.Errorf("error getting bucket tagging: %w", err)
+		}
+
+		for _, tag := range output.TagSet {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// PutS3BucketTagging sets the tags of a bucket through the S3 API, replacing all
+// existing tags. No tags removes the tags of the bucket, since the S3 API rejects an
+// empty tag set.
+func (c *Client) PutS3BucketTagging(ctx context.Context, bucketName string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return c.DeleteS3BucketTagging(ctx, bucketName)
+	}
+
+	// Sort the keys so that requests are reproducible
+	keys := slices.Sorted(maps.Keys(tags))
+
+	tagSet := make([]types.Tag, len(keys))
+	for i, key := range keys {
+		tagSet[i] = types.Tag{Key: aws.String(key), Value: aws.String(tags[key])}
+	}
+
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Setting bucket tagging", map[string]any{"bucket": bucketName, "tags": len(tagSet)})
+
+		_, err := client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+			Bucket:  aws.String(bucketName),
+			Tagging: &types.Tagging{TagSet: tagSet},
+		})
+		if err != nil {
+			return fmt.Errorf("error setting bucket tagging: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteS3BucketTagging removes the tags of a bucket through the S3 API. A bucket
+// without tags, or that does not exist, counts as done.
+func (c *Client) DeleteS3BucketTagging(ctx context.Context, bucketName string) error {
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Deleting bucket tagging", map[string]any{"bucket": bucketName})
+
+		_, err := client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			switch s3ErrorCode(err) {
+			case "NoSuchTagSet", "NoSuchTagSetError", "NoSuchBucket":
+				return nil
+			}
+			return fmt.Errorf("error deleting bucket tagging: %w", err)
+		}
+
+		return nil
+	})
+}
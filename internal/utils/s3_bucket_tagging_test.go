@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestS3BucketTagging(t *testing.T) {
+	client, server := newInMemoryS3SubresourceServer(t, "billing", "tagging", "NoSuchTagSet")
+
+	tags, err := client.GetS3BucketTagging(t.Context(), "billing")
+	if err != nil || len(tags) != 0 {
+		t.Fatalf("GetS3BucketTagging() = %v, %v, want no tags", tags, err)
+	}
+
+	want := map[string]string{"cost-center": "1234", "team": "storage"}
+	if err := client.PutS3BucketTagging(t.Context(), "billing", want); err != nil {
+		t.Fatalf("PutS3BucketTagging() error = %v", err)
+	}
+	if !strings.Contains(server.document(), "<Key>cost-center</Key>") {
+		t.Fatalf("PutS3BucketTagging() sent %s", server.document())
+	}
+
+	// The request body is a Tagging document, which is also the response of
+	// GetBucketTagging
+	tags, err = client.GetS3BucketTagging(t.Context(), "billing")
+	if err != nil {
+		t.Fatalf("GetS3BucketTagging() error = %v", err)
+	}
+	if !maps.Equal(tags, want) {
+		t.Fatalf("GetS3BucketTagging() = %v, want %v", tags, want)
+	}
+
+	// Setting no tags deletes the tag set
+	if err := client.PutS3BucketTagging(t.Context(), "billing", nil); err != nil {
+		t.Fatalf("PutS3BucketTagging() without tags error = %v", err)
+	}
+	if deletes := server.deleteCount(); deletes != 1 {
+		t.Fatalf("PutS3BucketTagging() without tags sent %d deletes, want 1", deletes)
+	}
+	if tags, err := client.GetS3BucketTagging(t.Context(), "billing"); err != nil || len(tags) != 0 {
+		t.Fatalf("GetS3BucketTagging() after delete = %v, %v, want no tags", tags, err)
+	}
+}
@@ -8,8 +8,9 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
-	"log"
+	"math"
 	"net"
 	"net/http"
 	"net/url"
@@ -25,8 +26,8 @@ import (
 
 const (
 	// bucketListIncludeParams specifies which additional fields to include when listing S3 buckets.
-	// Available values: compliance, region, s3ObjectLock, deleteObjects, crossGridReplication, quotaObjectBytes.
-	bucketListIncludeParams = "region,s3ObjectLock"
+	// Available values: compliance, region, s3ObjectLock, deleteObjectStatus, crossGridReplication, quotaObjectBytes.
+	bucketListIncludeParams = "compliance,region,s3ObjectLock"
 )
 
 // S3BucketAPIResponse represents the API response structure for S3 bucket data.
@@ -151,33 +152,104 @@ type DeleteObjectStatusConfig struct {
 
 // CrossGridReplicationConfig represents cross-grid replication settings.
 type CrossGridReplicationConfig struct {
-	Rules []any `json:"rules"`
+	Rules []CrossGridReplicationRule `json:"rules"`
 }
 
-// getCachedBucketList retrieves the bucket list with caching support.
-// Cache is valid for 5 minutes to balance between performance and freshness.
-// NOTE: Using simple caching without mutex for now. In case of concurrent access issues,
-// see the comment in Client struct for thread-safe implementation details.
-func (c *Client) getCachedBucketList() ([]S3BucketData, error) {
-	const cacheTimeout = 5 * time.Minute
+// CrossGridReplicationRule replicates the objects of the bucket to the bucket of the
+// same name on the grid at the other end of a grid federation connection.
+type CrossGridReplicationRule struct {
+	Grid string `json:"grid"`
+}
+
+// DefaultBucketCacheTTL is how long a cached bucket entry stays valid unless configured
+// otherwise. It balances between performance and freshness.
+const DefaultBucketCacheTTL = 5 * time.Minute
+
+// BucketCacheSession keeps cached bucket entries until a write through the client
+// invalidates them. Terraform starts the provider for each plan or apply, so every bucket
+// read of a run is served by a single list request.
+const BucketCacheSession time.Duration = math.MaxInt64
+
+// BucketListIncludeOptions are the fields that can be added to the bucket list on top of
+// bucketListIncludeParams, so that reads of them are served from the bucket cache.
+var BucketListIncludeOptions = []string{"deleteObjectStatus", "crossGridReplication"}
+
+// bucketCacheTimeout returns the configured cache TTL. It is not positive when the cache is disabled.
+func (c *Client) bucketCacheTimeout() time.Duration {
+	if c.bucketCacheTTL == 0 {
+		return DefaultBucketCacheTTL
+	}
+	return c.bucketCacheTTL
+}
+
+// bucketListInclude returns the include query parameter of bucket list requests.
+func (c *Client) bucketListInclude() string {
+	if len(c.bucketListIncludeExtra) == 0 {
+		return bucketListIncludeParams
+	}
+	return bucketListIncludeParams + "," + strings.Join(c.bucketListIncludeExtra, ",")
+}
+
+// getCachedBucket returns the cached entry for a bucket if it is present and still fresh.
+func (c *Client) getCachedBucket(bucketName string) (*S3BucketData, bool) {
+	c.bucketCacheMux.RLock()
+	defer c.bucketCacheMux.RUnlock()
+
+	entry, ok := c.bucketCache[bucketName]
+	if !ok || time.Since(entry.fetchedAt) >= c.bucketCacheTimeout() {
+		return nil, false
+	}
+
+	bucket := entry.bucket
+	return &bucket, true
+}
+
+// invalidateBucketCache drops the cache entry of a single bucket so the next lookup
+// fetches fresh data. Entries of other buckets are kept.
+func (c *Client) invalidateBucketCache(bucketName string) {
+	c.bucketCacheMux.Lock()
+	defer c.bucketCacheMux.Unlock()
+
+	delete(c.bucketCache, bucketName)
+	c.bucketCacheGen++
+}
 
-	// Simple cache check - potential race condition but not catastrophic
-	if time.Since(c.bucketCacheTime) < cacheTimeout && c.bucketCache != nil {
-		return c.bucketCache, nil
+// refreshBucketList fetches the bucket list for a cache miss. Concurrent callers share a
+// single request, which is not canceled when one of them gives up waiting.
+func (c *Client) refreshBucketList(ctx context.Context) ([]S3BucketData, error) {
+	ch := c.bucketListGroup.DoChan("buckets", func() (any, error) {
+		return c.fetchBucketList(context.WithoutCancel(ctx))
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]S3BucketData), nil
 	}
+}
+
+// fetchBucketList retrieves the bucket list and replaces the per-bucket cache entries.
+// The API has no endpoint for a single bucket, so any cache miss refreshes the whole list.
+func (c *Client) fetchBucketList(ctx context.Context) ([]S3BucketData, error) {
+	c.bucketCacheMux.RLock()
+	gen := c.bucketCacheGen
+	c.bucketCacheMux.RUnlock()
 
-	// Cache is expired or empty, fetch fresh data
-	reqUrl, err := url.Parse(fmt.Sprintf("%s/api/v4/org/containers", c.EndpointURL))
+	reqUrl, err := url.Parse(c.apiURL("/org/containers"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request url: %w", err)
 	}
 	// Add query params (See: <storagegrid>/ui/apidocs.html#/containers/get_org_containers
 	queryParams := reqUrl.Query()
-	// Add include query param to load region and ObjectLock values
-	queryParams.Add("include", bucketListIncludeParams)
+	// Add include query param to load region, compliance, ObjectLock and configured values
+	queryParams.Add("include", c.bucketListInclude())
 	reqUrl.RawQuery = queryParams.Encode()
 
-	req, err := http.NewRequest("GET", reqUrl.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqUrl.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -194,17 +266,29 @@ func (c *Client) getCachedBucketList() ([]S3BucketData, error) {
 		return nil, fmt.Errorf("error unmarshalling S3 bucket response: %w", err)
 	}
 
-	// Update cache (potential race condition - multiple goroutines might update simultaneously)
-	c.bucketCache = apiResponse.Data
-	c.bucketCacheTime = time.Now()
+	// Replace the cache so buckets deleted outside of Terraform disappear. A list that was
+	// requested before an entry was invalidated may predate the write, so it is not cached.
+	if c.bucketCacheTimeout() > 0 {
+		now := time.Now()
+		cache := make(map[string]bucketCacheEntry, len(apiResponse.Data))
+		for _, bucket := range apiResponse.Data {
+			cache[bucket.Name] = bucketCacheEntry{bucket: bucket, fetchedAt: now}
+		}
+
+		c.bucketCacheMux.Lock()
+		if c.bucketCacheGen == gen {
+			c.bucketCache = cache
+		}
+		c.bucketCacheMux.Unlock()
+	}
 
-	return c.bucketCache, nil
+	return apiResponse.Data, nil
 }
 
 // S3BucketCreateRequest represents the request body for creating an S3 bucket.
 type S3BucketCreateRequest struct {
 	Name         string                    `json:"name"`
-	Region       string                    `json:"region"`
+	Region       string                    `json:"region,omitempty"` // Empty uses the default region of the grid
 	S3ObjectLock *S3BucketCreateObjectLock `json:"s3ObjectLock,omitempty"`
 }
 
@@ -215,9 +299,11 @@ type S3BucketCreateObjectLock struct {
 }
 
 // S3BucketCreateRetentionSetting represents default retention settings for bucket creation.
+// StorageGrid requires exactly one of Days and Years.
 type S3BucketCreateRetentionSetting struct {
-	Mode string `json:"mode"`
-	Days int    `json:"days"`
+	Mode  string `json:"mode"`
+	Days  int    `json:"days,omitempty"`
+	Years int    `json:"years,omitempty"`
 }
 
 // S3BucketCreateResponse represents the API response structure for bucket creation.
@@ -250,9 +336,10 @@ type S3BucketAlert struct {
 }
 
 // CreateS3Bucket creates a new S3 bucket with the specified name, region, and object lock settings.
-func (c *Client) CreateS3Bucket(bucketName, region string, objectLockEnabled bool) error {
-	url := fmt.Sprintf("%s/api/v4/org/containers", c.EndpointURL)
-	log.Printf("Executing POST request to URL: %s", url)
+// With object lock enabled, objects get defaultRetention, or governance mode with 1 day
+// retention when it is nil.
+func (c *Client) CreateS3Bucket(ctx context.Context, bucketName, region string, objectLockEnabled bool, defaultRetention *S3BucketCreateRetentionSetting) error {
+	url := c.apiURL("/org/containers")
 
 	createRequest := S3BucketCreateRequest{
 		Name:   bucketName,
@@ -261,12 +348,15 @@ func (c *Client) CreateS3Bucket(bucketName, region string, objectLockEnabled boo
 
 	// Add object lock configuration if enabled
 	if objectLockEnabled {
-		createRequest.S3ObjectLock = &S3BucketCreateObjectLock{
-			Enabled: true,
-			DefaultRetentionSetting: &S3BucketCreateRetentionSetting{
+		if defaultRetention == nil {
+			defaultRetention = &S3BucketCreateRetentionSetting{
 				Mode: "governance", // Lighter than compliance mode
 				Days: 1,            // Default to 1 day to avoid problems
-			},
+			}
+		}
+		createRequest.S3ObjectLock = &S3BucketCreateObjectLock{
+			Enabled:                 true,
+			DefaultRetentionSetting: defaultRetention,
 		}
 	} else {
 		createRequest.S3ObjectLock = &S3BucketCreateObjectLock{
@@ -279,7 +369,7 @@ func (c *Client) CreateS3Bucket(bucketName, region string, objectLockEnabled boo
 		return fmt.Errorf("error marshalling bucket create request: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
@@ -300,78 +390,57 @@ func (c *Client) CreateS3Bucket(bucketName, region string, objectLockEnabled boo
 		return fmt.Errorf("bucket creation failed with status: %s", apiResponse.Status)
 	}
 
-	// Clear cache since we created a new bucket
-	c.bucketCache = nil
-	c.bucketCacheTime = time.Time{}
+	// Invalidate the cache entry since we created a new bucket
+	c.invalidateBucketCache(bucketName)
 
 	return nil
 }
 
 // DeleteS3Bucket deletes an S3 bucket by name.
-func (c *Client) DeleteS3Bucket(bucketName string) error {
-	url := fmt.Sprintf("%s/api/v4/org/containers/%s", c.EndpointURL, bucketName)
-	log.Printf("Executing DELETE request to URL: %s", url)
+func (c *Client) DeleteS3Bucket(ctx context.Context, bucketName string) error {
+	url := c.apiURL("/org/containers/%s", bucketName)
 
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return fmt.Errorf("error creating DELETE request: %w", err)
-	}
-
-	_, err = c.doRequest(req)
+	err := c.deleteAndVerify(ctx, url, func(ctx context.Context) error {
+		// The cached entry predates the delete
+		c.invalidateBucketCache(bucketName)
+		_, err := c.GetS3Bucket(ctx, bucketName)
+		return err
+	})
 	if err != nil {
-		// Check if this is a timeout error
-		if isTimeoutError(err) {
-			log.Printf("Delete request timed out, checking if bucket was actually deleted...")
-
-			// Wait a moment for the operation to complete
-			time.Sleep(2 * time.Second)
-
-			// Check if bucket still exists
-			_, checkErr := c.GetS3Bucket(bucketName)
-			if checkErr != nil && strings.Contains(checkErr.Error(), "not found") {
-				// Bucket was successfully deleted despite timeout
-				log.Printf("Bucket %s was successfully deleted despite timeout", bucketName)
-				c.bucketCache = nil
-				c.bucketCacheTime = time.Time{}
-				return nil
-			}
-		}
-		return fmt.Errorf("error executing DELETE request: %w", err)
+		return err
 	}
 
-	// Clear cache since we successfully deleted a bucket
-	c.bucketCache = nil
-	c.bucketCacheTime = time.Time{}
+	// Invalidate the cache entry since we successfully deleted a bucket
+	c.invalidateBucketCache(bucketName)
 
 	return nil
 }
 
-// isTimeoutError checks if an error is a timeout error.
+// isTimeoutError checks if an error, or an error it wraps, is a timeout error, such as
+// the *url.Error of an HTTP client timeout or an expired context deadline.
 func isTimeoutError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	// Check for context deadline exceeded
-	if err == context.DeadlineExceeded {
+	if errors.Is(err, context.DeadlineExceeded) {
 		return true
 	}
 
-	// Check for net timeout errors
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
 
-	// Check for timeout in error message
-	errStr := err.Error()
-	return strings.Contains(errStr, "timeout") ||
-		strings.Contains(errStr, "deadline exceeded") ||
-		strings.Contains(errStr, "Client.Timeout exceeded")
+// ListS3Buckets retrieves all S3 buckets of the tenant, bypassing the cache.
+func (c *Client) ListS3Buckets(ctx context.Context) ([]S3BucketData, error) {
+	return c.fetchBucketList(ctx)
 }
 
 // GetS3Bucket retrieves information about a specific S3 bucket by name.
-func (c *Client) GetS3Bucket(bucketName string) (*S3BucketData, error) {
-	buckets, err := c.getCachedBucketList()
+func (c *Client) GetS3Bucket(ctx context.Context, bucketName string) (*S3BucketData, error) {
+	bucket, ok := c.getCachedBucket(bucketName)
+	c.metrics.recordBucketCache(ok)
+	if ok {
+		return bucket, nil
+	}
+
+	buckets, err := c.refreshBucketList(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +452,7 @@ func (c *Client) GetS3Bucket(bucketName string) (*S3BucketData, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("bucket %s not found", bucketName)
+	return nil, fmt.Errorf("bucket %s %w", bucketName, ErrNotFound)
 }
 
 // S3BucketVersioningAPIResponse represents the API response structure for bucket versioning.
@@ -402,11 +471,10 @@ type S3BucketVersioningData struct {
 }
 
 // GetS3BucketVersioning retrieves versioning configuration for a specific S3 bucket.
-func (c *Client) GetS3BucketVersioning(bucketName string) (*S3BucketVersioningData, error) {
-	url := fmt.Sprintf("%s/api/v4/org/containers/%s/versioning", c.EndpointURL, bucketName)
-	log.Printf("Executing GET request to URL: %s", url)
+func (c *Client) GetS3BucketVersioning(ctx context.Context, bucketName string) (*S3BucketVersioningData, error) {
+	url := c.apiURL("/org/containers/%s/versioning", bucketName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -431,9 +499,8 @@ type S3BucketVersioningUpdateRequest struct {
 }
 
 // UpdateS3BucketVersioning updates versioning configuration for a specific S3 bucket.
-func (c *Client) UpdateS3BucketVersioning(bucketName string, versioningEnabled, versioningSuspended bool) error {
-	url := fmt.Sprintf("%s/api/v4/org/containers/%s/versioning", c.EndpointURL, bucketName)
-	log.Printf("Executing PUT request to URL: %s", url)
+func (c *Client) UpdateS3BucketVersioning(ctx context.Context, bucketName string, versioningEnabled, versioningSuspended bool) error {
+	url := c.apiURL("/org/containers/%s/versioning", bucketName)
 
 	updateRequest := S3BucketVersioningUpdateRequest{
 		VersioningEnabled:   versioningEnabled,
@@ -445,7 +512,7 @@ func (c *Client) UpdateS3BucketVersioning(bucketName string, versioningEnabled,
 		return fmt.Errorf("error marshalling bucket versioning update request: %w", err)
 	}
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("error creating PUT request: %w", err)
 	}
@@ -466,6 +533,9 @@ func (c *Client) UpdateS3BucketVersioning(bucketName string, versioningEnabled,
 		return fmt.Errorf("bucket versioning update failed with status: %s", apiResponse.Status)
 	}
 
+	// Invalidate the cache entry since the bucket configuration changed
+	c.invalidateBucketCache(bucketName)
+
 	return nil
 }
 
@@ -485,11 +555,10 @@ type S3BucketObjectLockData struct {
 }
 
 // GetS3BucketObjectLock retrieves object lock configuration for a specific S3 bucket.
-func (c *Client) GetS3BucketObjectLock(bucketName string) (*S3BucketObjectLockData, error) {
-	url := fmt.Sprintf("%s/api/v4/org/containers/%s/object-lock", c.EndpointURL, bucketName)
-	log.Printf("Executing GET request to URL: %s", url)
+func (c *Client) GetS3BucketObjectLock(ctx context.Context, bucketName string) (*S3BucketObjectLockData, error) {
+	url := c.apiURL("/org/containers/%s/object-lock", bucketName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -514,9 +583,8 @@ type S3BucketObjectLockUpdateRequest struct {
 }
 
 // UpdateS3BucketObjectLock updates object lock configuration for a specific S3 bucket.
-func (c *Client) UpdateS3BucketObjectLock(bucketName string, enabled bool, defaultRetentionSetting *DefaultRetentionSetting) error {
-	url := fmt.Sprintf("%s/api/v4/org/containers/%s/object-lock", c.EndpointURL, bucketName)
-	log.Printf("Executing PUT request to URL: %s", url)
+func (c *Client) UpdateS3BucketObjectLock(ctx context.Context, bucketName string, enabled bool, defaultRetentionSetting *DefaultRetentionSetting) error {
+	url := c.apiURL("/org/containers/%s/object-lock", bucketName)
 
 	updateRequest := S3BucketObjectLockUpdateRequest{
 		Enabled:                 enabled,
@@ -528,9 +596,7 @@ func (c *Client) UpdateS3BucketObjectLock(bucketName string, enabled bool, defau
 		return fmt.Errorf("error marshalling bucket object lock update request: %w", err)
 	}
 
-	log.Printf("Request body: %s", string(requestBody))
-
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return fmt.Errorf("error creating PUT request: %w", err)
 	}
@@ -551,6 +617,9 @@ func (c *Client) UpdateS3BucketObjectLock(bucketName string, enabled bool, defau
 		return fmt.Errorf("bucket object lock update failed with status: %s", apiResponse.Status)
 	}
 
+	// Invalidate the cache entry since the bucket configuration changed
+	c.invalidateBucketCache(bucketName)
+
 	return nil
 }
 
@@ -564,16 +633,36 @@ type LifecycleConfiguration struct {
 
 // Rule represents a lifecycle rule.
 type Rule struct {
-	ID                          string                       `xml:"ID,omitempty"`
-	Status                      string                       `xml:"Status"`
-	Filter                      *Filter                      `xml:"Filter,omitempty"`
-	Expiration                  *Expiration                  `xml:"Expiration,omitempty"`
-	NoncurrentVersionExpiration *NoncurrentVersionExpiration `xml:"NoncurrentVersionExpiration,omitempty"`
+	ID                             string                          `xml:"ID,omitempty"`
+	Status                         string                          `xml:"Status"`
+	Filter                         *Filter                         `xml:"Filter,omitempty"`
+	Expiration                     *Expiration                     `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
 }
 
-// Filter represents the filter for a lifecycle rule.
+// Filter represents the filter for a lifecycle rule. It holds a single predicate, a
+// prefix or an object size bound, or an And element combining several predicates.
 type Filter struct {
-	Prefix string `xml:"Prefix,omitempty"`
+	Prefix                string     `xml:"Prefix,omitempty"`
+	ObjectSizeGreaterThan int64      `xml:"ObjectSizeGreaterThan,omitempty"`
+	ObjectSizeLessThan    int64      `xml:"ObjectSizeLessThan,omitempty"`
+	And                   *FilterAnd `xml:"And,omitempty"`
+}
+
+// FilterAnd represents the And element of a lifecycle rule filter. Objects must match
+// all of its predicates.
+type FilterAnd struct {
+	Prefix                string      `xml:"Prefix,omitempty"`
+	Tags                  []FilterTag `xml:"Tag,omitempty"`
+	ObjectSizeGreaterThan int64       `xml:"ObjectSizeGreaterThan,omitempty"`
+	ObjectSizeLessThan    int64       `xml:"ObjectSizeLessThan,omitempty"`
+}
+
+// FilterTag represents an object tag a lifecycle rule filter matches.
+type FilterTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
 }
 
 // Expiration represents expiration settings for current versions.
@@ -584,8 +673,16 @@ type Expiration struct {
 }
 
 // NoncurrentVersionExpiration represents expiration settings for noncurrent versions.
+// NewerNoncurrentVersions is the number of most recent noncurrent versions that are kept.
 type NoncurrentVersionExpiration struct {
-	NoncurrentDays int `xml:"NoncurrentDays,omitempty"`
+	NoncurrentDays          int `xml:"NoncurrentDays,omitempty"`
+	NewerNoncurrentVersions int `xml:"NewerNoncurrentVersions,omitempty"`
+}
+
+// AbortIncompleteMultipartUpload represents the settings for aborting multipart uploads
+// that are not completed.
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation,omitempty"`
 }
 
 // S3AccessKeyResponse represents the API response for access key creation.
@@ -596,6 +693,13 @@ type S3AccessKeyResponse struct {
 	Data         s3AccessKey `json:"data"`
 }
 
+// Default management and S3 ports of StorageGrid, used to derive the S3 endpoint from
+// the management endpoint when no S3 endpoint is configured.
+const (
+	defaultMgmtPort = "9443"
+	defaultS3Port   = 10443
+)
+
 // GetS3EndpointURL returns the S3 endpoint URL, either from configuration or derived from management endpoint.
 func (c *Client) GetS3EndpointURL() (string, error) {
 	// Use configured S3 endpoint if available
@@ -603,21 +707,39 @@ func (c *Client) GetS3EndpointURL() (string, error) {
 		return c.S3EndpointURL, nil
 	}
 
-	// Return error if no S3 endpoint configured - S3 operations require explicit S3 endpoint
-	return "", fmt.Errorf("S3 endpoint not configured - S3 operations require an S3 endpoint to be specified in the provider configuration using endpoints.s3 or STORAGEGRID_S3_ENDPOINT environment variable")
+	return deriveS3EndpointURL(c.EndpointURL, c.S3Port)
+}
+
+// deriveS3EndpointURL returns the management endpoint with the S3 port. Without an explicit
+// port, only the default management port is mapped to the default S3 port, since other
+// ports usually mean a load balancer whose S3 address cannot be guessed.
+func deriveS3EndpointURL(mgmtEndpoint string, s3Port int) (string, error) {
+	endpoint, err := url.Parse(mgmtEndpoint)
+	if err != nil || endpoint.Host == "" {
+		return "", fmt.Errorf("invalid management endpoint %q: cannot derive an S3 endpoint from it", mgmtEndpoint)
+	}
+
+	if s3Port == 0 {
+		if endpoint.Port() != defaultMgmtPort {
+			return "", fmt.Errorf("S3 endpoint not configured - S3 operations require an S3 endpoint to be specified in the provider configuration using endpoints.s3 or STORAGEGRID_S3_ENDPOINT environment variable, " +
+				"or s3_port when the S3 API is served on the management host")
+		}
+		s3Port = defaultS3Port
+	}
+
+	return fmt.Sprintf("%s://%s", endpoint.Scheme, net.JoinHostPort(endpoint.Hostname(), strconv.Itoa(s3Port))), nil
 }
 
 // createTemporaryAccessKey creates a temporary access key for S3 operations.
-func (c *Client) createTemporaryAccessKey() (*s3AccessKey, error) {
-	url := fmt.Sprintf("%s/api/v4/org/users/current-user/s3-access-keys", c.EndpointURL)
-	log.Printf("Creating temporary access key via URL: %s", url)
+func (c *Client) createTemporaryAccessKey(ctx context.Context) (*s3AccessKey, error) {
+	url := c.apiURL("/org/users/current-user/s3-access-keys")
 
-	// Create request body for temporary access key with 2-hour expiration
-	// This is long enough for any terraform operation but short enough to not accumulate
-	expirationTime := time.Now().Add(2 * time.Hour)
-	requestBody := fmt.Appendf(nil, `{"expires": "%s"}`, expirationTime.Format("2006-01-02T15:04:05.000Z"))
+	requestBody, err := json.Marshal(c.temporaryKey.newPayload(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling access key request: %w", err)
+	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, fmt.Errorf("error creating access key request: %w", err)
 	}
@@ -644,111 +766,150 @@ func (c *Client) createTemporaryAccessKey() (*s3AccessKey, error) {
 }
 
 // deleteAccessKey deletes a temporary access key.
-func (c *Client) deleteAccessKey(accessKeyID string) error {
-	url := fmt.Sprintf("%s/api/v4/org/users/current-user/s3-access-keys/%s", c.EndpointURL, accessKeyID)
-	log.Printf("Deleting access key via URL: %s", url)
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return fmt.Errorf("error creating delete access key request: %w", err)
-	}
+func (c *Client) deleteAccessKey(ctx context.Context, accessKeyID string) error {
+	url := c.apiURL("/org/users/current-user/s3-access-keys/%s", accessKeyID)
 
-	_, err = c.doRequest(req)
-	if err != nil {
-		return fmt.Errorf("error executing delete access key request: %w", err)
-	}
-
-	return nil
+	return c.deleteAndVerify(ctx, url, func(ctx context.Context) error {
+		return c.getS3AccessKey(ctx, "current-user", accessKeyID)
+	})
 }
 
 // AcquireS3Client returns a cached AWS S3 client, creating it if necessary.
 // The client and access key are reused across all operations during the provider session.
-// Access keys are created with a 2-hour expiration and are NOT cleaned up during the session
-// to avoid complex lifecycle management issues with Terraform's execution model.
-func (c *Client) AcquireS3Client() (*s3.Client, error) {
+// Unless static S3 credentials are configured, a temporary access key is created, or a stored
+// one is reused, see TemporaryKeyOptions. It is NOT cleaned up during the session to avoid
+// complex lifecycle management issues with Terraform's execution model.
+func (c *Client) AcquireS3Client(ctx context.Context) (*s3.Client, error) {
 	c.s3ClientMutex.Lock()
 	defer c.s3ClientMutex.Unlock()
 
 	// Return cached client if available
 	if c.s3Client != nil {
-		log.Printf("Reusing cached S3 client (access key ID: %s)", c.s3AccessKey.ID)
+		logTrace(ctx, "Reusing cached S3 client")
 		return c.s3Client, nil
 	}
 
-	log.Printf("No cached S3 client found, creating new access key")
-
-	// Create temporary access key
-	accessKey, err := c.createTemporaryAccessKey()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temporary access key: %w", err)
-	}
-
 	// Parse S3 endpoint
 	s3EndpointURL, err := c.GetS3EndpointURL()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get S3 endpoint URL: %w", err)
 	}
 
+	accessKey := c.staticS3Key
+	stored := false
+	if accessKey == nil && c.temporaryKeyCacheFile != "" {
+		accessKey = c.loadReusableAccessKey(ctx)
+		stored = accessKey != nil
+	}
+	if accessKey == nil {
+		logDebug(ctx, "No cached S3 client found, creating temporary access key")
+
+		// Create temporary access key
+		accessKey, err = c.createTemporaryAccessKey(ctx)
+		if err != nil {
+			// Tenant users without the manage own S3 credentials permission cannot create
+			// keys, but S3 operations still work with a static key of another user
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+				return nil, fmt.Errorf("failed to create temporary access key, configure s3_access_key and s3_secret_key if the user may not manage its own S3 credentials: %w", err)
+			}
+			return nil, fmt.Errorf("failed to create temporary access key: %w", err)
+		}
+
+		if c.temporaryKeyCacheFile != "" {
+			// Without the stored copy, the key is deleted on exit as usual
+			if err := c.storeReusableAccessKey(accessKey); err != nil {
+				logWarn(ctx, "Failed to store temporary access key for later runs", map[string]any{"access_key_id": accessKey.ID, "error": err.Error()})
+			} else {
+				stored = true
+			}
+		}
+	}
+
+	// Shares the timeouts, and the TLS settings unless S3TLS is set, of the management API client
+	var httpClient aws.HTTPClient = c.s3HTTPDoer()
+	if c.metrics != nil {
+		httpClient = &meteredHTTPClient{next: httpClient, metrics: c.metrics}
+	}
+	if c.audit != nil {
+		httpClient = &auditedHTTPClient{next: httpClient, audit: c.audit}
+	}
+
 	// Create AWS S3 client with custom endpoint
 	s3Client := s3.NewFromConfig(aws.Config{
 		Region:      "us-east-1", // StorageGRID doesn't use regions but AWS SDK requires one
 		Credentials: credentials.NewStaticCredentialsProvider(accessKey.AccessKey, accessKey.SecretKey, ""),
+		HTTPClient:  httpClient,
+		Retryer:     func() aws.Retryer { return c.retry.s3Retryer() },
 	}, func(o *s3.Options) {
 		o.BaseEndpoint = aws.String(s3EndpointURL)
 		o.UsePathStyle = true // StorageGRID uses path-style URLs
+		o.APIOptions = append(o.APIOptions, c.s3APIOptions()...)
 	})
 
-	// Cache the client and access key
+	// Cache the client. Only temporary access keys are tracked for cleanup on exit.
 	c.s3Client = s3Client
+	if c.staticS3Key != nil {
+		logDebug(ctx, "Created S3 client with static access key")
+		return c.s3Client, nil
+	}
 	c.s3AccessKey = accessKey
+	c.s3AccessKeyStored = stored
 
-	log.Printf("Created and cached S3 client with temporary access key (ID: %s, expires in 2 hours)", accessKey.ID)
+	logDebug(ctx, "Created S3 client with temporary access key", map[string]any{"access_key_id": accessKey.ID, "expires": accessKey.Expires, "stored": stored})
 	return c.s3Client, nil
 }
 
 // clearS3ClientCache clears the S3 client cache WITHOUT deleting the access key.
 // The access key will expire automatically based on its expiration time.
-func (c *Client) clearS3ClientCache() {
+func (c *Client) clearS3ClientCache(ctx context.Context) {
 	if c.s3AccessKey != nil {
-		log.Printf("Clearing S3 client cache (access key %s will expire automatically)", c.s3AccessKey.ID)
+		logDebug(ctx, "Clearing S3 client cache, the access key will expire automatically", map[string]any{"access_key_id": c.s3AccessKey.ID})
+	}
+	// The key was rejected, so later runs must not reuse it either
+	if c.s3AccessKeyStored {
+		c.forgetReusableAccessKey(ctx)
 	}
 	c.s3Client = nil
 	c.s3AccessKey = nil
+	c.s3AccessKeyStored = false
 }
 
 // executeS3Operation executes an S3 operation with retry on authentication failure.
-// The S3 client and access key are cached and reused across operations.
-func (c *Client) executeS3Operation(operation func(*s3.Client) error) error {
-	client, err := c.AcquireS3Client()
+// The S3 client and access key are cached and reused across operations. The operation
+// gets ctx with the deadline of S3 operations, see Timeouts, and must use it for its
+// requests.
+func (c *Client) executeS3Operation(ctx context.Context, operation func(context.Context, *s3.Client) error) error {
+	ctx, cancel := c.s3OperationContext(ctx)
+	defer cancel()
+
+	client, err := c.AcquireS3Client(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to acquire S3 client: %w", err)
 	}
 
-	// Try the operation
-	err = operation(client)
+	// Try the operation. Acquiring the client may itself send a request, so the
+	// request slot is only held while the operation runs.
+	err = c.withRequestSlot(ctx, func() error { return operation(ctx, client) })
 	if err != nil {
 		// Check if it's an authentication/authorization error that might indicate expired/invalid key
-		errStr := err.Error()
-		if strings.Contains(errStr, "AccessDenied") ||
-			strings.Contains(errStr, "InvalidAccessKey") ||
-			strings.Contains(errStr, "TokenRefreshRequired") ||
-			strings.Contains(errStr, "ExpiredToken") {
-
-			log.Printf("S3 operation failed with auth error, clearing cache and retrying with fresh key: %v", err)
+		// A fresh key only helps with temporary keys, static keys are not replaced.
+		if c.staticS3Key == nil && isS3AccessKeyError(err) {
+			logWarn(ctx, "S3 operation failed with auth error, retrying with a fresh access key", map[string]any{"error": err.Error()})
 
 			// Clear cache (but don't delete the old key) and retry once with a fresh key
 			c.s3ClientMutex.Lock()
-			c.clearS3ClientCache()
+			c.clearS3ClientCache(ctx)
 			c.s3ClientMutex.Unlock()
 
 			// Get a fresh client
-			client, retryErr := c.AcquireS3Client()
+			client, retryErr := c.AcquireS3Client(ctx)
 			if retryErr != nil {
 				return fmt.Errorf("failed to refresh S3 client after auth error: %w", retryErr)
 			}
 
 			// Retry the operation
-			if retryErr := operation(client); retryErr != nil {
+			if retryErr := c.withRequestSlot(ctx, func() error { return operation(ctx, client) }); retryErr != nil {
 				return fmt.Errorf("S3 operation failed after retry: %w", retryErr)
 			}
 			return nil
@@ -764,18 +925,24 @@ func (c *Client) GetS3AccessKey() *s3AccessKey {
 }
 
 // GetS3BucketLifecycleConfiguration retrieves lifecycle configuration for a specific S3 bucket.
-func (c *Client) GetS3BucketLifecycleConfiguration(bucketName string) (*LifecycleConfiguration, error) {
+func (c *Client) GetS3BucketLifecycleConfiguration(ctx context.Context, bucketName string) (*LifecycleConfiguration, error) {
 	var result *LifecycleConfiguration
 	var operationErr error
 
-	err := c.executeS3Operation(func(client *s3.Client) error {
-		log.Printf("Getting lifecycle configuration for bucket: %s", bucketName)
+	err := c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Getting lifecycle configuration", map[string]any{"bucket": bucketName})
 
 		// Get lifecycle configuration using AWS SDK
-		output, err := client.GetBucketLifecycleConfiguration(context.Background(), &s3.GetBucketLifecycleConfigurationInput{
+		output, err := client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(bucketName),
 		})
 		if err != nil {
+			// A bucket without lifecycle rules is reported as an error by the S3 API;
+			// treat it as an empty configuration so callers don't need to special-case it.
+			if s3ErrorCode(err) == "NoSuchLifecycleConfiguration" {
+				result = &LifecycleConfiguration{Rules: []Rule{}}
+				return nil
+			}
 			return fmt.Errorf("error getting bucket lifecycle configuration: %w", err)
 		}
 
@@ -794,9 +961,21 @@ func (c *Client) GetS3BucketLifecycleConfiguration(bucketName string) (*Lifecycl
 			// created without one; an empty prefix matches all objects and is
 			// equivalent to no filter, so don't materialize it into state (otherwise a
 			// config that omits the filter block produces a perpetual diff).
-			if rule.Filter != nil && aws.ToString(rule.Filter.Prefix) != "" {
+			if rule.Filter != nil && rule.Filter.And != nil {
+				and := &FilterAnd{
+					Prefix:                aws.ToString(rule.Filter.And.Prefix),
+					ObjectSizeGreaterThan: aws.ToInt64(rule.Filter.And.ObjectSizeGreaterThan),
+					ObjectSizeLessThan:    aws.ToInt64(rule.Filter.And.ObjectSizeLessThan),
+				}
+				for _, tag := range rule.Filter.And.Tags {
+					and.Tags = append(and.Tags, FilterTag{Key: aws.ToString(tag.Key), Value: aws.ToString(tag.Value)})
+				}
+				lifecycleRule.Filter = &Filter{And: and}
+			} else if rule.Filter != nil && (aws.ToString(rule.Filter.Prefix) != "" || rule.Filter.ObjectSizeGreaterThan != nil || rule.Filter.ObjectSizeLessThan != nil) {
 				lifecycleRule.Filter = &Filter{
-					Prefix: aws.ToString(rule.Filter.Prefix),
+					Prefix:                aws.ToString(rule.Filter.Prefix),
+					ObjectSizeGreaterThan: aws.ToInt64(rule.Filter.ObjectSizeGreaterThan),
+					ObjectSizeLessThan:    aws.ToInt64(rule.Filter.ObjectSizeLessThan),
 				}
 			}
 
@@ -817,7 +996,15 @@ func (c *Client) GetS3BucketLifecycleConfiguration(bucketName string) (*Lifecycl
 			// Handle noncurrent version expiration
 			if rule.NoncurrentVersionExpiration != nil && rule.NoncurrentVersionExpiration.NoncurrentDays != nil {
 				lifecycleRule.NoncurrentVersionExpiration = &NoncurrentVersionExpiration{
-					NoncurrentDays: int(*rule.NoncurrentVersionExpiration.NoncurrentDays),
+					NoncurrentDays:          int(*rule.NoncurrentVersionExpiration.NoncurrentDays),
+					NewerNoncurrentVersions: int(aws.ToInt32(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions)),
+				}
+			}
+
+			// Handle incomplete multipart upload abortion
+			if rule.AbortIncompleteMultipartUpload != nil && rule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+				lifecycleRule.AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{
+					DaysAfterInitiation: int(*rule.AbortIncompleteMultipartUpload.DaysAfterInitiation),
 				}
 			}
 
@@ -839,9 +1026,9 @@ func (c *Client) GetS3BucketLifecycleConfiguration(bucketName string) (*Lifecycl
 }
 
 // PutS3BucketLifecycleConfiguration sets lifecycle configuration for a specific S3 bucket.
-func (c *Client) PutS3BucketLifecycleConfiguration(bucketName string, lifecycleConfig *LifecycleConfiguration) error {
-	return c.executeS3Operation(func(client *s3.Client) error {
-		log.Printf("Setting lifecycle configuration for bucket: %s", bucketName)
+func (c *Client) PutS3BucketLifecycleConfiguration(ctx context.Context, bucketName string, lifecycleConfig *LifecycleConfiguration) error {
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Setting lifecycle configuration", map[string]any{"bucket": bucketName})
 
 		// Convert our struct to AWS SDK lifecycle format
 		rules := make([]types.LifecycleRule, len(lifecycleConfig.Rules))
@@ -854,12 +1041,25 @@ func (c *Client) PutS3BucketLifecycleConfiguration(bucketName string, lifecycleC
 
 			// Handle filter. The v2 lifecycle schema requires every rule to carry a
 			// <Filter> element; omitting it results in a MalformedXML error. An empty
-			// filter matches all objects, so send one whenever no prefix is configured.
-			if rule.Filter != nil && rule.Filter.Prefix != "" {
+			// filter matches all objects, so send one whenever no predicate is configured.
+			switch {
+			case rule.Filter != nil && rule.Filter.And != nil:
+				awsRule.Filter = &types.LifecycleRuleFilter{
+					And: lifecycleRuleAndOperator(rule.Filter.And),
+				}
+			case rule.Filter != nil && rule.Filter.Prefix != "":
 				awsRule.Filter = &types.LifecycleRuleFilter{
 					Prefix: aws.String(rule.Filter.Prefix),
 				}
-			} else {
+			case rule.Filter != nil && rule.Filter.ObjectSizeGreaterThan > 0:
+				awsRule.Filter = &types.LifecycleRuleFilter{
+					ObjectSizeGreaterThan: aws.Int64(rule.Filter.ObjectSizeGreaterThan),
+				}
+			case rule.Filter != nil && rule.Filter.ObjectSizeLessThan > 0:
+				awsRule.Filter = &types.LifecycleRuleFilter{
+					ObjectSizeLessThan: aws.Int64(rule.Filter.ObjectSizeLessThan),
+				}
+			default:
 				awsRule.Filter = &types.LifecycleRuleFilter{}
 			}
 
@@ -884,13 +1084,23 @@ func (c *Client) PutS3BucketLifecycleConfiguration(bucketName string, lifecycleC
 				awsRule.NoncurrentVersionExpiration = &types.NoncurrentVersionExpiration{
 					NoncurrentDays: aws.Int32(int32(rule.NoncurrentVersionExpiration.NoncurrentDays)),
 				}
+				if rule.NoncurrentVersionExpiration.NewerNoncurrentVersions > 0 {
+					awsRule.NoncurrentVersionExpiration.NewerNoncurrentVersions = aws.Int32(int32(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions))
+				}
+			}
+
+			// Handle incomplete multipart upload abortion
+			if rule.AbortIncompleteMultipartUpload != nil {
+				awsRule.AbortIncompleteMultipartUpload = &types.AbortIncompleteMultipartUpload{
+					DaysAfterInitiation: aws.Int32(int32(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+				}
 			}
 
 			rules[i] = awsRule
 		}
 
 		// Set lifecycle configuration using AWS SDK
-		_, err := client.PutBucketLifecycleConfiguration(context.Background(), &s3.PutBucketLifecycleConfigurationInput{
+		_, err := client.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
 			Bucket: aws.String(bucketName),
 			LifecycleConfiguration: &types.BucketLifecycleConfiguration{
 				Rules: rules,
@@ -904,13 +1114,32 @@ func (c *Client) PutS3BucketLifecycleConfiguration(bucketName string, lifecycleC
 	})
 }
 
+// lifecycleRuleAndOperator converts the And element of a filter to the AWS SDK format,
+// leaving out unset predicates.
+func lifecycleRuleAndOperator(and *FilterAnd) *types.LifecycleRuleAndOperator {
+	operator := &types.LifecycleRuleAndOperator{}
+	if and.Prefix != "" {
+		operator.Prefix = aws.String(and.Prefix)
+	}
+	for _, tag := range and.Tags {
+		operator.Tags = append(operator.Tags, types.Tag{Key: aws.String(tag.Key), Value: aws.String(tag.Value)})
+	}
+	if and.ObjectSizeGreaterThan > 0 {
+		operator.ObjectSizeGreaterThan = aws.Int64(and.ObjectSizeGreaterThan)
+	}
+	if and.ObjectSizeLessThan > 0 {
+		operator.ObjectSizeLessThan = aws.Int64(and.ObjectSizeLessThan)
+	}
+	return operator
+}
+
 // DeleteS3BucketLifecycleConfiguration deletes lifecycle configuration for a specific S3 bucket.
-func (c *Client) DeleteS3BucketLifecycleConfiguration(bucketName string) error {
-	return c.executeS3Operation(func(client *s3.Client) error {
-		log.Printf("Deleting lifecycle configuration for bucket: %s", bucketName)
+func (c *Client) DeleteS3BucketLifecycleConfiguration(ctx context.Context, bucketName string) error {
+	return c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Deleting lifecycle configuration", map[string]any{"bucket": bucketName})
 
 		// Remove lifecycle configuration using AWS SDK
-		_, err := client.DeleteBucketLifecycle(context.Background(), &s3.DeleteBucketLifecycleInput{
+		_, err := client.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
 			Bucket: aws.String(bucketName),
 		})
 		if err != nil {
@@ -920,3 +1149,40 @@ func (c *Client) DeleteS3BucketLifecycleConfiguration(bucketName string) error {
 		return nil
 	})
 }
+
+// S3EndpointCheckResult holds the outcome of a ListBuckets probe against the S3 endpoint.
+type S3EndpointCheckResult struct {
+	Endpoint    string
+	Latency     time.Duration
+	BucketCount int
+}
+
+// CheckS3Endpoint verifies that the S3 endpoint is reachable with the provider credentials
+// by performing a ListBuckets request and measuring its latency.
+func (c *Client) CheckS3Endpoint(ctx context.Context) (*S3EndpointCheckResult, error) {
+	endpoint, err := c.GetS3EndpointURL()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &S3EndpointCheckResult{Endpoint: endpoint}
+
+	err = c.executeS3Operation(ctx, func(ctx context.Context, client *s3.Client) error {
+		logDebug(ctx, "Checking S3 endpoint", map[string]any{"endpoint": endpoint})
+
+		start := time.Now()
+		output, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+		result.Latency = time.Since(start)
+		if err != nil {
+			return fmt.Errorf("error listing buckets: %w", err)
+		}
+
+		result.BucketCount = len(output.Buckets)
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
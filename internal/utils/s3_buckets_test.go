@@ -6,9 +6,15 @@ package utils
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -108,7 +114,7 @@ func TestDefaultRetentionSettingMarshalJSON(t *testing.T) {
 	}
 }
 
-func TestGetCachedBucketListUsesIncludeQueryAndCache(t *testing.T) {
+func TestGetS3BucketUsesIncludeQueryAndCache(t *testing.T) {
 	requests := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requests++
@@ -143,7 +149,7 @@ func TestGetCachedBucketListUsesIncludeQueryAndCache(t *testing.T) {
 	}
 
 	for i := range 2 {
-		bucket, err := client.GetS3Bucket("logs")
+		bucket, err := client.GetS3Bucket(context.Background(), "logs")
 		if err != nil {
 			t.Fatalf("GetS3Bucket attempt %d returned error: %v", i+1, err)
 		}
@@ -156,7 +162,7 @@ func TestGetCachedBucketListUsesIncludeQueryAndCache(t *testing.T) {
 	}
 }
 
-func TestGetCachedBucketListRefreshesExpiredCache(t *testing.T) {
+func TestGetS3BucketRefreshesExpiredCacheEntry(t *testing.T) {
 	requests := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requests++
@@ -166,14 +172,15 @@ func TestGetCachedBucketListRefreshesExpiredCache(t *testing.T) {
 	defer server.Close()
 
 	client := &Client{
-		EndpointURL:     server.URL,
-		HTTPClient:      server.Client(),
-		Token:           "test-token",
-		bucketCache:     []S3BucketData{{Name: "logs"}},
-		bucketCacheTime: time.Now().Add(-6 * time.Minute),
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+		bucketCache: map[string]bucketCacheEntry{
+			"logs": {bucket: S3BucketData{Name: "logs"}, fetchedAt: time.Now().Add(-6 * time.Minute)},
+		},
 	}
 
-	if _, err := client.GetS3Bucket("logs"); err != nil {
+	if _, err := client.GetS3Bucket(context.Background(), "logs"); err != nil {
 		t.Fatalf("GetS3Bucket returned error: %v", err)
 	}
 	if requests != 1 {
@@ -181,10 +188,199 @@ func TestGetCachedBucketListRefreshesExpiredCache(t *testing.T) {
 	}
 }
 
+func TestGetS3BucketKeepsSessionCacheEntries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":[{"name":"logs"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:    server.URL,
+		HTTPClient:     server.Client(),
+		Token:          "test-token",
+		bucketCacheTTL: BucketCacheSession,
+		bucketCache: map[string]bucketCacheEntry{
+			"logs": {bucket: S3BucketData{Name: "logs"}, fetchedAt: time.Now().Add(-time.Hour)},
+		},
+	}
+
+	if _, err := client.GetS3Bucket(context.Background(), "logs"); err != nil {
+		t.Fatalf("GetS3Bucket returned error: %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want the session cache entry to be used", requests)
+	}
+
+	client.invalidateBucketCache("logs")
+	if _, err := client.GetS3Bucket(context.Background(), "logs"); err != nil {
+		t.Fatalf("GetS3Bucket returned error: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("server received %d requests, want 1 request after invalidation", requests)
+	}
+}
+
+func TestGetS3BucketIncludesConfiguredFields(t *testing.T) {
+	var include string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		include = r.URL.Query().Get("include")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":[{"name":"logs","deleteObjectStatus":{"isDeletingObjects":true}}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:            server.URL,
+		HTTPClient:             server.Client(),
+		Token:                  "test-token",
+		bucketListIncludeExtra: []string{"deleteObjectStatus"},
+	}
+
+	bucket, err := client.GetS3Bucket(context.Background(), "logs")
+	if err != nil {
+		t.Fatalf("GetS3Bucket returned error: %v", err)
+	}
+	if want := "compliance,region,s3ObjectLock,deleteObjectStatus"; include != want {
+		t.Fatalf("include = %q, want %q", include, want)
+	}
+	if bucket.DeleteStatus == nil || !bucket.DeleteStatus.IsDeletingObjects {
+		t.Fatalf("DeleteStatus = %#v, want the deletion in progress", bucket.DeleteStatus)
+	}
+}
+
+func TestGetS3BucketSharesConcurrentRefreshes(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":[{"name":"logs"},{"name":"other"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"logs", "other", "logs", "other", "logs"} {
+		wg.Go(func() {
+			if _, err := client.GetS3Bucket(context.Background(), name); err != nil {
+				t.Errorf("GetS3Bucket(%s) returned error: %v", name, err)
+			}
+		})
+	}
+	// Give the goroutines time to join the in-flight request before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("server received %d requests, want 1 shared request", got)
+	}
+}
+
+func TestGetS3BucketWithDisabledCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":[{"name":"logs"}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:    server.URL,
+		HTTPClient:     server.Client(),
+		Token:          "test-token",
+		bucketCacheTTL: -1,
+	}
+
+	for range 2 {
+		if _, err := client.GetS3Bucket(context.Background(), "logs"); err != nil {
+			t.Fatalf("GetS3Bucket returned error: %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("server received %d requests, want 2 uncached requests", requests)
+	}
+}
+
+func TestSubConfigurationWritesInvalidateBucketCacheEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		write func(c *Client) error
+	}{
+		{
+			name: "versioning",
+			path: "/api/v4/org/containers/logs/versioning",
+			write: func(c *Client) error {
+				return c.UpdateS3BucketVersioning(context.Background(), "logs", true, false)
+			},
+		},
+		{
+			name: "object lock",
+			path: "/api/v4/org/containers/logs/object-lock",
+			write: func(c *Client) error {
+				return c.UpdateS3BucketObjectLock(context.Background(), "logs", true, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("method = %s, want %s", r.Method, http.MethodPut)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				if r.URL.Path != tt.path {
+					t.Errorf("path = %s, want %s", r.URL.Path, tt.path)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"success","data":{}}`))
+			}))
+			defer server.Close()
+
+			client := &Client{
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+				bucketCache: map[string]bucketCacheEntry{
+					"logs":  {bucket: S3BucketData{Name: "logs"}, fetchedAt: time.Now()},
+					"other": {bucket: S3BucketData{Name: "other"}, fetchedAt: time.Now()},
+				},
+			}
+
+			if err := tt.write(client); err != nil {
+				t.Fatalf("write returned error: %v", err)
+			}
+			if _, ok := client.bucketCache["logs"]; ok {
+				t.Fatal("cache entry for logs still present after write")
+			}
+			if _, ok := client.getCachedBucket("other"); !ok {
+				t.Fatal("cache entry for other was dropped by write")
+			}
+		})
+	}
+}
+
 func TestCreateS3BucketRequest(t *testing.T) {
 	tests := []struct {
 		name              string
 		objectLockEnabled bool
+		defaultRetention  *S3BucketCreateRetentionSetting
 		wantObjectLock    S3BucketCreateObjectLock
 	}{
 		{
@@ -203,6 +399,18 @@ func TestCreateS3BucketRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:              "with default retention",
+			objectLockEnabled: true,
+			defaultRetention:  &S3BucketCreateRetentionSetting{Mode: "compliance", Years: 7},
+			wantObjectLock: S3BucketCreateObjectLock{
+				Enabled: true,
+				DefaultRetentionSetting: &S3BucketCreateRetentionSetting{
+					Mode:  "compliance",
+					Years: 7,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -241,22 +449,24 @@ func TestCreateS3BucketRequest(t *testing.T) {
 			defer server.Close()
 
 			client := &Client{
-				EndpointURL:     server.URL,
-				HTTPClient:      server.Client(),
-				Token:           "test-token",
-				bucketCache:     []S3BucketData{{Name: "stale"}},
-				bucketCacheTime: time.Now(),
-				S3EndpointURL:   "https://s3.example.com",
+				EndpointURL: server.URL,
+				HTTPClient:  server.Client(),
+				Token:       "test-token",
+				bucketCache: map[string]bucketCacheEntry{
+					"logs":  {bucket: S3BucketData{Name: "logs"}, fetchedAt: time.Now()},
+					"other": {bucket: S3BucketData{Name: "other"}, fetchedAt: time.Now()},
+				},
+				S3EndpointURL: "https://s3.example.com",
 			}
 
-			if err := client.CreateS3Bucket("logs", "us-east-1", tt.objectLockEnabled); err != nil {
+			if err := client.CreateS3Bucket(context.Background(), "logs", "us-east-1", tt.objectLockEnabled, tt.defaultRetention); err != nil {
 				t.Fatalf("CreateS3Bucket returned error: %v", err)
 			}
-			if client.bucketCache != nil {
-				t.Fatalf("bucketCache = %#v, want nil after create", client.bucketCache)
+			if _, ok := client.bucketCache["logs"]; ok {
+				t.Fatal("cache entry for logs still present after create")
 			}
-			if !client.bucketCacheTime.IsZero() {
-				t.Fatalf("bucketCacheTime = %v, want zero after create", client.bucketCacheTime)
+			if _, ok := client.bucketCache["other"]; !ok {
+				t.Fatal("cache entry for other was dropped by create")
 			}
 		})
 	}
@@ -278,16 +488,31 @@ func TestIsTimeoutError(t *testing.T) {
 			err:  context.DeadlineExceeded,
 			want: true,
 		},
+		{
+			name: "wrapped context deadline",
+			err:  fmt.Errorf("error executing DELETE request: %w", context.DeadlineExceeded),
+			want: true,
+		},
 		{
 			name: "net timeout",
 			err:  timeoutError{},
 			want: true,
 		},
 		{
-			name: "message timeout",
-			err:  errString("Client.Timeout exceeded while awaiting headers"),
+			name: "wrapped HTTP client timeout",
+			err:  fmt.Errorf("error executing DELETE request: %w", &url.Error{Op: "Delete", URL: "https://grid.example.com", Err: timeoutError{}}),
 			want: true,
 		},
+		{
+			name: "message mentioning a timeout",
+			err:  fmt.Errorf("error executing DELETE request: %w", errString("bucket policy sets a timeout")),
+			want: false,
+		},
+		{
+			name: "canceled context",
+			err:  fmt.Errorf("error executing DELETE request: %w", context.Canceled),
+			want: false,
+		},
 		{
 			name: "non timeout",
 			err:  errString("not found"),
@@ -323,3 +548,186 @@ func (timeoutError) Timeout() bool {
 func (timeoutError) Temporary() bool {
 	return true
 }
+
+func TestGetS3EndpointURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *Client
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "configured endpoint",
+			client: &Client{EndpointURL: "https://grid.example.com:9443", S3EndpointURL: "https://s3.example.com", S3Port: 8082},
+			want:   "https://s3.example.com",
+		},
+		{
+			name:   "default management port",
+			client: &Client{EndpointURL: "https://grid.example.com:9443"},
+			want:   "https://grid.example.com:10443",
+		},
+		{
+			name:   "explicit S3 port",
+			client: &Client{EndpointURL: "https://grid.example.com", S3Port: 8082},
+			want:   "https://grid.example.com:8082",
+		},
+		{
+			name:    "load balancer without S3 port",
+			client:  &Client{EndpointURL: "https://grid.example.com"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.GetS3EndpointURL()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetS3EndpointURL() error = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("GetS3EndpointURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcquireS3ClientUsesStaticAccessKey(t *testing.T) {
+	var authorization atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/") {
+			t.Errorf("unexpected management API request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		authorization.Store(r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code></Error>`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+	}
+
+	config, err := client.GetS3BucketLifecycleConfiguration(t.Context(), "my-bucket")
+	if err != nil {
+		t.Fatalf("GetS3BucketLifecycleConfiguration() error = %v", err)
+	}
+	if len(config.Rules) != 0 {
+		t.Fatalf("expected no lifecycle rules, got %d", len(config.Rules))
+	}
+	if got, _ := authorization.Load().(string); !strings.Contains(got, "Credential=STATICKEY/") {
+		t.Fatalf("expected the request to be signed with the static key, got Authorization %q", got)
+	}
+	if client.s3AccessKey != nil {
+		t.Fatal("static access key must not be tracked for cleanup")
+	}
+}
+
+func TestAcquireS3ClientSuggestsStaticKeyWhenForbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": {"key": "forbidden", "text": "Access denied"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+	}
+
+	_, err := client.AcquireS3Client(t.Context())
+	if err == nil || !strings.Contains(err.Error(), "s3_access_key") {
+		t.Fatalf("AcquireS3Client() error = %v, want a hint to configure s3_access_key", err)
+	}
+}
+
+func TestS3BucketLifecycleRules(t *testing.T) {
+	var mu sync.Mutex
+	var stored []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			stored, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write(stored)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+	}
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantXML string
+	}{
+		{
+			name: "and filter",
+			rule: Rule{ID: "rule", Status: "Enabled", Expiration: &Expiration{Days: 30}, Filter: &Filter{And: &FilterAnd{
+				Prefix:                "reports/",
+				Tags:                  []FilterTag{{Key: "team", Value: "finance"}},
+				ObjectSizeGreaterThan: 1024,
+				ObjectSizeLessThan:    1048576,
+			}}},
+			wantXML: "<And><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan>",
+		},
+		{
+			name:    "object size greater than filter",
+			rule:    Rule{ID: "rule", Status: "Enabled", Expiration: &Expiration{Days: 30}, Filter: &Filter{ObjectSizeGreaterThan: 104857600}},
+			wantXML: "<Filter><ObjectSizeGreaterThan>104857600</ObjectSizeGreaterThan></Filter>",
+		},
+		{
+			name:    "object size less than filter",
+			rule:    Rule{ID: "rule", Status: "Enabled", Expiration: &Expiration{Days: 30}, Filter: &Filter{ObjectSizeLessThan: 4096}},
+			wantXML: "<Filter><ObjectSizeLessThan>4096</ObjectSizeLessThan></Filter>",
+		},
+		{
+			name:    "newer noncurrent versions",
+			rule:    Rule{ID: "rule", Status: "Enabled", NoncurrentVersionExpiration: &NoncurrentVersionExpiration{NoncurrentDays: 30, NewerNoncurrentVersions: 5}},
+			wantXML: "<NewerNoncurrentVersions>5</NewerNoncurrentVersions>",
+		},
+		{
+			name:    "abort incomplete multipart upload",
+			rule:    Rule{ID: "rule", Status: "Enabled", AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 3}},
+			wantXML: "<AbortIncompleteMultipartUpload><DaysAfterInitiation>3</DaysAfterInitiation></AbortIncompleteMultipartUpload>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &LifecycleConfiguration{Rules: []Rule{tt.rule}}
+			if err := client.PutS3BucketLifecycleConfiguration(t.Context(), "my-bucket", config); err != nil {
+				t.Fatalf("PutS3BucketLifecycleConfiguration() error = %v", err)
+			}
+			if !strings.Contains(string(stored), tt.wantXML) {
+				t.Fatalf("PutS3BucketLifecycleConfiguration() sent %s, want %s", stored, tt.wantXML)
+			}
+
+			got, err := client.GetS3BucketLifecycleConfiguration(t.Context(), "my-bucket")
+			if err != nil {
+				t.Fatalf("GetS3BucketLifecycleConfiguration() error = %v", err)
+			}
+			if len(got.Rules) != 1 || !reflect.DeepEqual(got.Rules[0], tt.rule) {
+				t.Fatalf("GetS3BucketLifecycleConfiguration() = %+v, want %+v", got.Rules, tt.rule)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// S3ObjectLockSettingsAPIResponse represents the API response structure for the global S3 Object Lock settings.
+type S3ObjectLockSettingsAPIResponse struct {
+	ResponseTime string                   `json:"responseTime"`
+	Status       string                   `json:"status"`
+	APIVersion   string                   `json:"apiVersion"`
+	Deprecated   bool                     `json:"deprecated"`
+	Data         S3ObjectLockSettingsData `json:"data"`
+}
+
+// S3ObjectLockSettingsData represents the global S3 Object Lock settings visible to the tenant.
+type S3ObjectLockSettingsData struct {
+	Enabled bool `json:"enabled"`
+}
+
+// GetS3ObjectLockSettings retrieves whether S3 Object Lock is enabled for the grid.
+func (c *Client) GetS3ObjectLockSettings(ctx context.Context) (*S3ObjectLockSettingsData, error) {
+	url := c.apiURL("/org/s3-object-lock")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse S3ObjectLockSettingsAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling S3 object lock settings response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
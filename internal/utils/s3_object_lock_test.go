@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetS3ObjectLockSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("method = %s, want %s", r.Method, http.MethodGet)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path != "/api/v4/org/s3-object-lock" {
+			t.Errorf("path = %s, want /api/v4/org/s3-object-lock", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "success", "apiVersion": "4.0", "data": {"enabled": true}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	settings, err := client.GetS3ObjectLockSettings(context.Background())
+	if err != nil {
+		t.Fatalf("GetS3ObjectLockSettings returned error: %v", err)
+	}
+	if !settings.Enabled {
+		t.Fatal("settings.Enabled = false, want true")
+	}
+}
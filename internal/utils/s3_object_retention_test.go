@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetS3ObjectLockStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		retention     string
+		legalHold     string
+		wantRetention *ObjectRetention
+		wantLegalHold bool
+	}{
+		{
+			name:          "retention and legal hold",
+			retention:     `<Retention><Mode>GOVERNANCE</Mode><RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate></Retention>`,
+			legalHold:     `<LegalHold><Status>ON</Status></LegalHold>`,
+			wantRetention: &ObjectRetention{Mode: "governance", RetainUntilDate: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+			wantLegalHold: true,
+		},
+		{
+			name: "no retention or legal hold",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/my-bucket/reports/2026.csv" {
+					t.Errorf("path = %s, want /my-bucket/reports/2026.csv", r.URL.Path)
+				}
+				if got := r.URL.Query().Get("versionId"); got != "v1" {
+					t.Errorf("versionId = %q, want v1", got)
+				}
+
+				body := tt.legalHold
+				if r.URL.Query().Has("retention") {
+					body = tt.retention
+				}
+				w.Header().Set("Content-Type", "application/xml")
+				if body == "" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`<Error><Code>NoSuchObjectLockConfiguration</Code></Error>`))
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			status, err := newS3TestClient(server).GetS3ObjectLockStatus(t.Context(), "my-bucket", "reports/2026.csv", "v1")
+			if err != nil {
+				t.Fatalf("GetS3ObjectLockStatus() error = %v", err)
+			}
+			if status.LegalHold != tt.wantLegalHold {
+				t.Errorf("LegalHold = %t, want %t", status.LegalHold, tt.wantLegalHold)
+			}
+			switch {
+			case tt.wantRetention == nil && status.Retention != nil:
+				t.Errorf("Retention = %+v, want nil", status.Retention)
+			case tt.wantRetention != nil && (status.Retention == nil || status.Retention.Mode != tt.wantRetention.Mode || !status.Retention.RetainUntilDate.Equal(tt.wantRetention.RetainUntilDate)):
+				t.Errorf("Retention = %+v, want %+v", status.Retention, tt.wantRetention)
+			}
+		})
+	}
+}
+
+func TestGetS3ObjectLockStatusNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+	}))
+	defer server.Close()
+
+	_, err := newS3TestClient(server).GetS3ObjectLockStatus(t.Context(), "my-bucket", "missing", "")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetS3ObjectLockStatus() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPutS3ObjectRetentionAndLegalHold(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]string{}
+	bypass := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s, want %s", r.Method, http.MethodPut)
+		}
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		for _, subresource := range []string{"retention", "legal-hold"} {
+			if r.URL.Query().Has(subresource) {
+				requests[subresource] = string(body)
+				bypass[subresource] = r.Header.Get("X-Amz-Bypass-Governance-Retention")
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newS3TestClient(server)
+	retention := &ObjectRetention{Mode: "compliance", RetainUntilDate: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if err := client.PutS3ObjectRetention(t.Context(), "my-bucket", "report.csv", "", retention, true); err != nil {
+		t.Fatalf("PutS3ObjectRetention() error = %v", err)
+	}
+	if err := client.PutS3ObjectLegalHold(t.Context(), "my-bucket", "report.csv", "", true); err != nil {
+		t.Fatalf("PutS3ObjectLegalHold() error = %v", err)
+	}
+
+	if got := requests["retention"]; !strings.Contains(got, "<Mode>COMPLIANCE</Mode>") || !strings.Contains(got, "<RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate>") {
+		t.Errorf("retention body = %s, want COMPLIANCE until 2030-01-01T00:00:00Z", got)
+	}
+	if got := bypass["retention"]; got != "true" {
+		t.Errorf("bypass governance retention header = %q, want true", got)
+	}
+	if got := requests["legal-hold"]; !strings.Contains(got, "<Status>ON</Status>") {
+		t.Errorf("legal hold body = %s, want status ON", got)
+	}
+}
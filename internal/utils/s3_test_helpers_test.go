@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func newS3TestClient(server *httptest.Server) *Client {
+	return &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+	}
+}
+
+// inMemoryS3Subresource stores the document of a bucket subresource, such as the tag set
+// of ?tagging, for a fake S3 endpoint.
+type inMemoryS3Subresource struct {
+	mu       sync.Mutex
+	stored   string
+	deletes  int
+	notFound string
+}
+
+// document returns the stored document, empty when none is stored.
+func (s *inMemoryS3Subresource) document() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stored
+}
+
+// deleteCount returns the number of DELETE requests received.
+func (s *inMemoryS3Subresource) deleteCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deletes
+}
+
+func (s *inMemoryS3Subresource) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.stored = string(body)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		if s.stored == "" {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>` + s.notFound + `</Code></Error>`))
+			return
+		}
+		if strings.HasPrefix(s.stored, "{") {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/xml")
+		}
+		_, _ = w.Write([]byte(s.stored))
+	case http.MethodDelete:
+		s.deletes++
+		s.stored = ""
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// newInMemoryS3SubresourceServer starts a fake S3 endpoint that stores the subresource of
+// bucket in memory, as sent by PUT and removed by DELETE. GET answers with the stored
+// document, or with the S3 error notFoundCode while none is stored. It returns a client
+// with a static S3 access key for the endpoint.
+func newInMemoryS3SubresourceServer(t *testing.T, bucket, subresource, notFoundCode string) (*Client, *inMemoryS3Subresource) {
+	t.Helper()
+
+	store := &inMemoryS3Subresource{notFound: notFoundCode}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+bucket || !r.URL.Query().Has(subresource) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		store.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return newS3TestClient(server), store
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SAMLResponseFunc signs in to the identity provider of a grid with single sign-on. It
+// gets the identity provider sign-in URL, which carries the SAML request, and returns
+// the base64 encoded SAML response of the identity provider.
+type SAMLResponseFunc func(ctx context.Context, signInURL string) (string, error)
+
+// ssoResponse maps to the JSON responses of the SSO sign-in endpoints, whose data is
+// the identity provider sign-in URL or the bearer token.
+type ssoResponse struct {
+	Status string `json:"status"`
+	Data   string `json:"data"`
+}
+
+// SignInSSO signs in to a tenant account of a grid that enforces single sign-on and
+// returns the bearer token. StorageGrid issues a SAML request for the account, the
+// identity provider is signed in to by samlResponse, and StorageGrid exchanges the
+// SAML response for a token.
+func (c *Client) SignInSSO(ctx context.Context, accountID string, samlResponse SAMLResponseFunc) (string, error) {
+	payload, err := json.Marshal(map[string]string{"accountId": accountID})
+	if err != nil {
+		return "", fmt.Errorf("error marshalling SSO payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL("/authorize-saml"), bytes.NewBuffer(payload))
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	logDebug(ctx, "Requesting SSO sign-in", map[string]any{"account_id": accountID})
+	signInURL, err := c.sendSSORequest(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting SSO sign-in: %w", err)
+	}
+
+	response, err := samlResponse(ctx, signInURL)
+	if err != nil {
+		return "", fmt.Errorf("error signing in to the identity provider: %w", err)
+	}
+
+	// The relay state identifies the account and is returned to StorageGrid as is
+	relayState := accountID
+	if parsed, err := url.Parse(signInURL); err == nil && parsed.Query().Get("RelayState") != "" {
+		relayState = parsed.Query().Get("RelayState")
+	}
+
+	form := url.Values{"SAMLResponse": {strings.TrimSpace(response)}, "RelayState": {relayState}}
+	req, err = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/saml-response", c.EndpointURL), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	token, err := c.sendSSORequest(req)
+	if err != nil {
+		return "", fmt.Errorf("error exchanging the SAML response: %w", err)
+	}
+	return token, nil
+}
+
+// sendSSORequest sends an unauthenticated SSO sign-in request and returns the data of
+// the response. The response is not logged, as it may hold the token.
+func (c *Client) sendSSORequest(req *http.Request) (string, error) {
+	res, body, err := c.send(req)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", newAPIError(res.StatusCode, body)
+	}
+
+	var response ssoResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	if response.Data == "" {
+		return "", fmt.Errorf("empty response data with status: %s", response.Status)
+	}
+	return response.Data, nil
+}
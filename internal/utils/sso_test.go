@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewClientSignsInWithSSO(t *testing.T) {
+	const signInURL = "https://adfs.example.com/adfs/ls/?SAMLRequest=fZHLbsIwEEX3&RelayState=12345"
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/versions":
+			_, _ = w.Write([]byte(`{"status": "success", "data": [4]}`))
+		case "/api/v4/authorize-saml":
+			var payload map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload["accountId"] != "12345" {
+				t.Errorf("unexpected authorize-saml payload %v, error %v", payload, err)
+			}
+			_, _ = w.Write([]byte(`{"status": "success", "data": "` + signInURL + `"}`))
+		case "/api/saml-response":
+			if got := r.PostFormValue("SAMLResponse"); got != "PHNhbWxwOlJlc3BvbnNlPg==" {
+				t.Errorf("SAMLResponse = %q", got)
+			}
+			if got := r.PostFormValue("RelayState"); got != "12345" {
+				t.Errorf("RelayState = %q, want %q", got, "12345")
+			}
+			_, _ = w.Write([]byte(`{"status": "success", "data": "sso-token"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	samlResponse := func(_ context.Context, gotURL string) (string, error) {
+		if gotURL != signInURL {
+			t.Errorf("sign-in URL = %q, want %q", gotURL, signInURL)
+		}
+		return "PHNhbWxwOlJlc3BvbnNlPg==\n", nil
+	}
+
+	accountID := "12345"
+	client, err := NewClient(t.Context(), &server.URL, nil, &accountID, nil, nil, ClientOptions{SAMLResponse: samlResponse, InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.Token != "sso-token" {
+		t.Fatalf("Token = %q, want %q", client.Token, "sso-token")
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultTemporaryKeyLifetime is how long temporary S3 access keys are valid unless
+// configured otherwise. It is long enough for any terraform operation but short enough
+// to not accumulate.
+const DefaultTemporaryKeyLifetime = 2 * time.Hour
+
+// TemporaryKeyOptions configures the temporary S3 access key the client creates when no
+// static S3 credentials are set.
+type TemporaryKeyOptions struct {
+	// Lifetime is how long the key is valid. Zero uses DefaultTemporaryKeyLifetime.
+	Lifetime time.Duration
+	// DisplayNamePrefix labels the key in StorageGrid, followed by the creation time.
+	// Empty creates the key without a display name.
+	DisplayNamePrefix string
+	// Reuse keeps the key when the provider exits and uses it again in later runs
+	// while at least half of its lifetime remains. The key and its secret are stored
+	// in a file that only the current user can read.
+	Reuse bool
+}
+
+func (o TemporaryKeyOptions) lifetime() time.Duration {
+	if o.Lifetime <= 0 {
+		return DefaultTemporaryKeyLifetime
+	}
+	return o.Lifetime
+}
+
+// temporaryKeyPayload is the request body for creating a temporary access key.
+type temporaryKeyPayload struct {
+	Expires     string `json:"expires"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// newPayload returns the create request body for a key created at now.
+func (o TemporaryKeyOptions) newPayload(now time.Time) temporaryKeyPayload {
+	payload := temporaryKeyPayload{
+		Expires: now.Add(o.lifetime()).UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+	if o.DisplayNamePrefix != "" {
+		payload.DisplayName = o.DisplayNamePrefix + now.UTC().Format("20060102-150405")
+	}
+	return payload
+}
+
+// temporaryKeyCacheFile returns the file a reusable temporary key for the endpoint and
+// user is stored in. Keys of different grids, accounts and users never share a file.
+func temporaryKeyCacheFile(endpoint, accountID, username string) (string, error) {
+	return userCacheFile("s3-access-key", endpoint, accountID, username)
+}
+
+// userCacheFile returns the file in the user cache directory that credentials of kind
+// for the endpoint and user are stored in.
+func userCacheFile(kind, endpoint, accountID, username string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(endpoint + "\x00" + accountID + "\x00" + username))
+	return filepath.Join(dir, "terraform-provider-storagegrid", kind+"-"+hex.EncodeToString(sum[:16])+".json"), nil
+}
+
+// loadReusableAccessKey returns the stored temporary key if it still exists and at least
+// half of the configured lifetime remains, or nil when a new key has to be created.
+func (c *Client) loadReusableAccessKey(ctx context.Context) *s3AccessKey {
+	data, err := os.ReadFile(c.temporaryKeyCacheFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logWarn(ctx, "Failed to read stored temporary access key", map[string]any{"path": c.temporaryKeyCacheFile, "error": err.Error()})
+		}
+		return nil
+	}
+
+	var key s3AccessKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		logWarn(ctx, "Ignoring invalid stored temporary access key", map[string]any{"path": c.temporaryKeyCacheFile, "error": err.Error()})
+		return nil
+	}
+
+	expires, err := time.Parse(time.RFC3339, key.Expires)
+	if err != nil || time.Until(expires) < c.temporaryKey.lifetime()/2 {
+		logDebug(ctx, "Stored temporary access key expires soon, creating a new one", map[string]any{"access_key_id": key.ID, "expires": key.Expires})
+		return nil
+	}
+
+	// The key may have been deleted in StorageGrid since it was stored
+	keys, err := c.GetS3AccessKeys(ctx, "current-user")
+	if err != nil {
+		logWarn(ctx, "Failed to check stored temporary access key, creating a new one", map[string]any{"access_key_id": key.ID, "error": err.Error()})
+		return nil
+	}
+	for _, existing := range keys.Data {
+		if existing.ID == key.ID {
+			return &key
+		}
+	}
+
+	logDebug(ctx, "Stored temporary access key no longer exists, creating a new one", map[string]any{"access_key_id": key.ID})
+	return nil
+}
+
+// storeReusableAccessKey stores a temporary key for later runs.
+func (c *Client) storeReusableAccessKey(key *s3AccessKey) error {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.temporaryKeyCacheFile), 0o700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.temporaryKeyCacheFile, data, 0o600); err != nil {
+		return fmt.Errorf("error writing %s: %w", c.temporaryKeyCacheFile, err)
+	}
+	return nil
+}
+
+// forgetReusableAccessKey removes the stored temporary key, so that the next run does
+// not try it again.
+func (c *Client) forgetReusableAccessKey(ctx context.Context) {
+	if err := os.Remove(c.temporaryKeyCacheFile); err != nil && !os.IsNotExist(err) {
+		logWarn(ctx, "Failed to remove stored temporary access key", map[string]any{"path": c.temporaryKeyCacheFile, "error": err.Error()})
+	}
+}
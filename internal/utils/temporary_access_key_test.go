@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTemporaryKeyPayload(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts TemporaryKeyOptions
+		want temporaryKeyPayload
+	}{
+		{
+			name: "defaults",
+			want: temporaryKeyPayload{Expires: "2026-03-01T14:30:00.000Z"},
+		},
+		{
+			name: "lifetime and display name prefix",
+			opts: TemporaryKeyOptions{Lifetime: 24 * time.Hour, DisplayNamePrefix: "terraform-ci-"},
+			want: temporaryKeyPayload{Expires: "2026-03-02T12:30:00.000Z", DisplayName: "terraform-ci-20260301-123000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.newPayload(now); got != tt.want {
+				t.Fatalf("newPayload() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcquireS3ClientReusesStoredTemporaryKey(t *testing.T) {
+	var created, deleted atomic.Int32
+	expires := time.Now().Add(2 * time.Hour).UTC().Format("2006-01-02T15:04:05.000Z")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/org/users/current-user/s3-access-keys":
+			created.Add(1)
+			fmt.Fprintf(w, `{"status":"success","data":{"id":"key-1","accessKey":"AKIA","secretAccessKey":"secret","expires":%q}}`, expires)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/org/users/current-user/s3-access-keys":
+			fmt.Fprintf(w, `{"status":"success","data":[{"id":"key-1","expires":%q}]}`, expires)
+		case r.Method == http.MethodDelete:
+			deleted.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cacheFile := filepath.Join(t.TempDir(), "s3-access-key.json")
+	newTestClient := func() *Client {
+		return &Client{
+			EndpointURL:           server.URL,
+			S3EndpointURL:         server.URL,
+			HTTPClient:            server.Client(),
+			Token:                 "test-token",
+			temporaryKey:          TemporaryKeyOptions{Reuse: true},
+			temporaryKeyCacheFile: cacheFile,
+		}
+	}
+
+	first := newTestClient()
+	if _, err := first.AcquireS3Client(t.Context()); err != nil {
+		t.Fatalf("AcquireS3Client() error = %v", err)
+	}
+	first.cleanupS3AccessKey(t.Context())
+
+	second := newTestClient()
+	if _, err := second.AcquireS3Client(t.Context()); err != nil {
+		t.Fatalf("AcquireS3Client() error = %v", err)
+	}
+	if second.s3AccessKey == nil || second.s3AccessKey.SecretKey != "secret" {
+		t.Fatalf("expected the stored key to be reused, got %+v", second.s3AccessKey)
+	}
+	if got := created.Load(); got != 1 {
+		t.Errorf("expected 1 access key to be created, got %d", got)
+	}
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("expected the stored key to be kept, got %d deletes", got)
+	}
+}
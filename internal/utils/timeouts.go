@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultRequestTimeout is used for every operation class without a configured timeout.
+const DefaultRequestTimeout = 60 * time.Second
+
+// DefaultS3OperationTimeout is used for S3 operations without a configured timeout.
+const DefaultS3OperationTimeout = 5 * time.Minute
+
+// Timeouts holds the per-request timeouts for each class of operation.
+// A zero value means the request has no deadline.
+type Timeouts struct {
+	Read   time.Duration
+	Write  time.Duration
+	Delete time.Duration
+	// S3Operation bounds an S3 operation as a whole, including the retries of its
+	// requests and the S3 client setup, each request being bounded by its own class.
+	S3Operation time.Duration
+}
+
+// withDefaults returns a copy of t with unset timeouts set to DefaultRequestTimeout, or
+// DefaultS3OperationTimeout for S3 operations.
+func (t Timeouts) withDefaults() Timeouts {
+	if t.Read == 0 {
+		t.Read = DefaultRequestTimeout
+	}
+	if t.Write == 0 {
+		t.Write = DefaultRequestTimeout
+	}
+	if t.Delete == 0 {
+		t.Delete = DefaultRequestTimeout
+	}
+	if t.S3Operation == 0 {
+		t.S3Operation = DefaultS3OperationTimeout
+	}
+	return t
+}
+
+// forMethod returns the timeout that applies to a request with the given HTTP method.
+func (t Timeouts) forMethod(method string) time.Duration {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return t.Read
+	case http.MethodDelete:
+		return t.Delete
+	default:
+		return t.Write
+	}
+}
+
+// deadlineHTTPClient applies the timeout of the request's operation class to every
+// request. It is used for the management API requests and passed to the S3 client.
+type deadlineHTTPClient struct {
+	client   *http.Client
+	timeouts Timeouts
+}
+
+func (d *deadlineHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	timeout := d.timeouts.forMethod(req.Method)
+	if timeout <= 0 {
+		return d.client.Do(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	res, err := d.client.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The deadline also covers reading the body, so only release it once the body is closed
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelOnCloseBody cancels the request context when the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// s3OperationContext returns ctx with the deadline of an S3 operation.
+func (c *Client) s3OperationContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeouts.S3Operation <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.timeouts.S3Operation)
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoRequestAppliesTimeoutByMethod(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status": "success"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+		timeouts: Timeouts{
+			Read:   10 * time.Millisecond,
+			Write:  time.Second,
+			Delete: time.Second,
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("GET: expected the read timeout to be exceeded")
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		req, err := http.NewRequest(method, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := client.doRequest(req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", method, err)
+		}
+		if string(body) != `{"status": "success"}` {
+			t.Fatalf("%s: body = %q", method, body)
+		}
+	}
+}
+
+func TestS3OperationTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL:   server.URL,
+		S3EndpointURL: server.URL,
+		HTTPClient:    server.Client(),
+		Token:         "test-token",
+		staticS3Key:   &s3AccessKey{AccessKey: "STATICKEY", SecretKey: "static-secret"},
+		timeouts:      Timeouts{Read: time.Minute, S3Operation: 50 * time.Millisecond},
+	}
+
+	start := time.Now()
+	if _, err := client.GetS3BucketLifecycleConfiguration(t.Context(), "my-bucket"); err == nil {
+		t.Fatal("expected the S3 operation timeout to be exceeded")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("S3 operation took %s, expected it to stop at its timeout", elapsed)
+	}
+}
+
+func TestTimeoutsWithDefaults(t *testing.T) {
+	got := Timeouts{Delete: 10 * time.Minute}.withDefaults()
+	want := Timeouts{Read: DefaultRequestTimeout, Write: DefaultRequestTimeout, Delete: 10 * time.Minute, S3Operation: DefaultS3OperationTimeout}
+	if got != want {
+		t.Fatalf("withDefaults() = %#v, want %#v", got, want)
+	}
+}
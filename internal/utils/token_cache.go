@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Bearer tokens of StorageGrid expire 16 hours after sign-in. Cached tokens are only used
+// while at least tokenCacheMargin of that remains, so that a run does not start with a
+// token that expires halfway.
+const (
+	tokenLifetime    = 16 * time.Hour
+	tokenCacheMargin = 30 * time.Minute
+)
+
+// Concurrent runs take turns signing in through a lock file next to the cache file. A
+// lock older than tokenCacheLockStale was left behind by a run that did not finish.
+var (
+	tokenCacheLockTimeout = 30 * time.Second
+	tokenCacheLockStale   = time.Minute
+	tokenCacheLockPoll    = 100 * time.Millisecond
+)
+
+// cachedToken is the content of the token cache file.
+type cachedToken struct {
+	Token   string `json:"token"`
+	Expires string `json:"expires"`
+}
+
+// tokenCacheFile returns the file the bearer token for the endpoint and user is cached
+// in. Tokens of different grids, accounts and users never share a file.
+func tokenCacheFile(endpoint, accountID, username string) (string, error) {
+	return userCacheFile("token", endpoint, accountID, username)
+}
+
+// signInWithTokenCache returns the cached token unless it is staleToken or expires soon,
+// and otherwise signs in and caches the new token. Runs sharing the cache sign in one at
+// a time, so that they reuse the token of the first one.
+func (c *Client) signInWithTokenCache(ctx context.Context, payload SignInBody, staleToken string) (string, error) {
+	unlock, err := lockTokenCache(ctx, c.tokenCacheFile+".lock")
+	if err != nil {
+		// Without the lock the token is still valid, it is only not shared
+		logWarn(ctx, "Failed to lock the token cache, signing in without it", map[string]any{"path": c.tokenCacheFile, "error": err.Error()})
+		ar, err := c.SignIn(ctx, payload)
+		if err != nil {
+			return "", err
+		}
+		return ar.Token, nil
+	}
+	defer unlock()
+
+	if token := c.loadCachedToken(ctx); token != "" && token != staleToken {
+		logDebug(ctx, "Using cached bearer token", map[string]any{"path": c.tokenCacheFile})
+		return token, nil
+	}
+
+	ar, err := c.SignIn(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+	if err := c.storeCachedToken(ar.Token, time.Now().Add(tokenLifetime)); err != nil {
+		logWarn(ctx, "Failed to cache bearer token for later runs", map[string]any{"path": c.tokenCacheFile, "error": err.Error()})
+	}
+	return ar.Token, nil
+}
+
+// loadCachedToken returns the cached token, or an empty string when there is none or it
+// expires within tokenCacheMargin.
+func (c *Client) loadCachedToken(ctx context.Context) string {
+	data, err := os.ReadFile(c.tokenCacheFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logWarn(ctx, "Failed to read cached bearer token", map[string]any{"path": c.tokenCacheFile, "error": err.Error()})
+		}
+		return ""
+	}
+
+	var cached cachedToken
+	if err := json.Unmarshal(data, &cached); err != nil {
+		logWarn(ctx, "Ignoring invalid cached bearer token", map[string]any{"path": c.tokenCacheFile, "error": err.Error()})
+		return ""
+	}
+
+	expires, err := time.Parse(time.RFC3339, cached.Expires)
+	if err != nil || time.Until(expires) < tokenCacheMargin {
+		logDebug(ctx, "Cached bearer token expires soon, signing in", map[string]any{"expires": cached.Expires})
+		return ""
+	}
+	return cached.Token
+}
+
+// storeCachedToken caches token for later runs. The file is replaced atomically so that
+// runs reading it without the lock never see a partial token.
+func (c *Client) storeCachedToken(token string, expires time.Time) error {
+	data, err := json.Marshal(cachedToken{Token: token, Expires: expires.UTC().Format(time.RFC3339)})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.tokenCacheFile), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.tokenCacheFile), filepath.Base(c.tokenCacheFile)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing %s: %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.tokenCacheFile)
+}
+
+// lockTokenCache creates the lock file at name, waiting while another run holds it. It
+// returns the function that releases the lock.
+func lockTokenCache(ctx context.Context, name string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(tokenCacheLockTimeout)
+	for {
+		file, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(name) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(name); err == nil && time.Since(info.ModTime()) > tokenCacheLockStale {
+			os.Remove(name)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for %s", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(tokenCacheLockPoll):
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSignInWithTokenCache(t *testing.T) {
+	var signIns atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/authorize" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"status": "success", "data": "token-%d"}`, signIns.Add(1))
+	}))
+	defer server.Close()
+
+	cacheFile := filepath.Join(t.TempDir(), "token.json")
+	newTestClient := func() *Client {
+		return &Client{EndpointURL: server.URL, HTTPClient: server.Client(), tokenCacheFile: cacheFile}
+	}
+	payload := SignInBody{AccountID: "12345", Username: "admin", Password: "secret"}
+
+	token, err := newTestClient().signIn(t.Context(), payload, "")
+	if err != nil || token != "token-1" {
+		t.Fatalf("signIn() = %q, %v, want token-1", token, err)
+	}
+
+	// A later run uses the cached token without signing in
+	token, err = newTestClient().signIn(t.Context(), payload, "")
+	if err != nil || token != "token-1" || signIns.Load() != 1 {
+		t.Fatalf("signIn() = %q, %v after %d sign-ins, want the cached token-1", token, err, signIns.Load())
+	}
+
+	// A rejected token is not taken from the cache again
+	token, err = newTestClient().signIn(t.Context(), payload, "token-1")
+	if err != nil || token != "token-2" {
+		t.Fatalf("signIn() = %q, %v, want token-2", token, err)
+	}
+
+	// Tokens that expire soon are replaced
+	client := newTestClient()
+	if err := client.storeCachedToken("token-2", time.Now().Add(tokenCacheMargin/2)); err != nil {
+		t.Fatal(err)
+	}
+	token, err = client.signIn(t.Context(), payload, "")
+	if err != nil || token != "token-3" {
+		t.Fatalf("signIn() = %q, %v, want token-3", token, err)
+	}
+
+	info, err := os.Stat(cacheFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("cache file mode = %o, want 600", perm)
+	}
+	if _, err := os.Stat(cacheFile + ".lock"); !os.IsNotExist(err) {
+		t.Fatalf("expected the lock file to be removed, got %v", err)
+	}
+}
+
+func TestLockTokenCache(t *testing.T) {
+	tokenCacheLockTimeout = 50 * time.Millisecond
+	tokenCacheLockPoll = 10 * time.Millisecond
+	t.Cleanup(func() {
+		tokenCacheLockTimeout = 30 * time.Second
+		tokenCacheLockPoll = 100 * time.Millisecond
+	})
+
+	lockFile := filepath.Join(t.TempDir(), "token.json.lock")
+	unlock, err := lockTokenCache(t.Context(), lockFile)
+	if err != nil {
+		t.Fatalf("lockTokenCache() error = %v", err)
+	}
+	if _, err := lockTokenCache(t.Context(), lockFile); err == nil {
+		t.Fatal("expected a timeout while the lock is held")
+	}
+	unlock()
+
+	// A lock left behind by a run that did not finish is taken over
+	if err := os.WriteFile(lockFile, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stale := time.Now().Add(-2 * tokenCacheLockStale)
+	if err := os.Chtimes(lockFile, stale, stale); err != nil {
+		t.Fatal(err)
+	}
+	unlock, err = lockTokenCache(t.Context(), lockFile)
+	if err != nil {
+		t.Fatalf("lockTokenCache() with a stale lock error = %v", err)
+	}
+	unlock()
+}
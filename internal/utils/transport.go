@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Connection pool defaults, used for ConnectionOptions fields that are not set.
+const (
+	DefaultMaxIdleConns    = 100
+	DefaultIdleConnTimeout = 90 * time.Second
+)
+
+// ConnectionOptions tunes the pool of connections to the management and S3 endpoints.
+type ConnectionOptions struct {
+	// MaxIdleConns is the number of idle connections kept open to each endpoint for
+	// later requests. Zero uses DefaultMaxIdleConns.
+	MaxIdleConns int
+	// MaxConnsPerHost limits the connections to each endpoint, including those in use.
+	// Requests beyond the limit wait for a connection. Zero means unlimited.
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept open. Zero uses
+	// DefaultIdleConnTimeout.
+	IdleConnTimeout time.Duration
+}
+
+// withDefaults returns a copy of o with unset values set to their defaults.
+func (o ConnectionOptions) withDefaults() ConnectionOptions {
+	if o.MaxIdleConns == 0 {
+		o.MaxIdleConns = DefaultMaxIdleConns
+	}
+	if o.IdleConnTimeout == 0 {
+		o.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	return o
+}
+
+// transportKey identifies the settings of a shared transport.
+type transportKey struct {
+	tls   TLSOptions
+	conns ConnectionOptions
+}
+
+// Transports shared by all clients with the same settings, so that the tenant and grid
+// clients, and the management and S3 APIs, reuse each other's connections.
+var (
+	transports   = map[transportKey]*http.Transport{}
+	transportsMu sync.Mutex
+)
+
+// newHTTPClient creates an HTTP client for the management or the S3 API, configured
+// with the TLS settings from opts and the connection pool settings from conns. Clients
+// with the same settings share their transport.
+func newHTTPClient(opts TLSOptions, conns ConnectionOptions) (*http.Client, error) {
+	key := transportKey{tls: opts, conns: conns.withDefaults()}
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	transport, ok := transports[key]
+	if !ok {
+		var err error
+		transport, err = newTransport(key.tls, key.conns)
+		if err != nil {
+			return nil, err
+		}
+		transports[key] = transport
+	}
+
+	// Deadlines are applied per request by operation class, see Timeouts
+	return &http.Client{Transport: transport}, nil
+}
+
+// newTransport creates a transport with the TLS and connection pool settings.
+func newTransport(opts TLSOptions, conns ConnectionOptions) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify, // #nosec G402 -- explicitly requested by the provider configuration
+		ServerName:         opts.ServerName,
+	}
+
+	if opts.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(opts.CACertPEM)) {
+			return nil, fmt.Errorf("no valid certificates found in the CA certificate PEM")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	// A provider talks to one or two endpoints, so all idle connections may go to one
+	// host instead of the default of two per host.
+	transport.MaxIdleConns = conns.MaxIdleConns
+	transport.MaxIdleConnsPerHost = conns.MaxIdleConns
+	transport.MaxConnsPerHost = conns.MaxConnsPerHost
+	transport.IdleConnTimeout = conns.IdleConnTimeout
+	return transport, nil
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClientSharesTransport(t *testing.T) {
+	first, err := newHTTPClient(TLSOptions{InsecureSkipVerify: true}, ConnectionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := newHTTPClient(TLSOptions{InsecureSkipVerify: true}, ConnectionOptions{MaxIdleConns: DefaultMaxIdleConns})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Transport != second.Transport {
+		t.Fatal("expected clients with the same settings to share their transport")
+	}
+
+	tuned, err := newHTTPClient(TLSOptions{InsecureSkipVerify: true}, ConnectionOptions{MaxIdleConns: 20, MaxConnsPerHost: 10, IdleConnTimeout: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tuned.Transport == first.Transport {
+		t.Fatal("expected clients with other connection settings to use another transport")
+	}
+
+	transport := tuned.Transport.(*http.Transport)
+	if transport.MaxIdleConns != 20 || transport.MaxIdleConnsPerHost != 20 || transport.MaxConnsPerHost != 10 || transport.IdleConnTimeout != time.Minute {
+		t.Fatalf("transport settings = MaxIdleConns %d, MaxIdleConnsPerHost %d, MaxConnsPerHost %d, IdleConnTimeout %s",
+			transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost, transport.IdleConnTimeout)
+	}
+}
+
+func TestNewHTTPClientServerName(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	// The test certificate is issued for example.com, and the server is addressed by IP
+	client, err := newHTTPClient(TLSOptions{CACertPEM: caCertPEM, ServerName: "example.com"}, ConnectionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request with the server name of the certificate failed: %v", err)
+	}
+	_ = res.Body.Close()
+
+	client, err = newHTTPClient(TLSOptions{CACertPEM: caCertPEM, ServerName: "s3.example.net"}, ConnectionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Fatal("expected a certificate error for another server name")
+	}
+}
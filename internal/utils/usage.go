@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TenantUsageAPIResponse represents the API response structure for tenant usage data.
+type TenantUsageAPIResponse struct {
+	ResponseTime string          `json:"responseTime"`
+	Status       string          `json:"status"`
+	APIVersion   string          `json:"apiVersion"`
+	Deprecated   bool            `json:"deprecated"`
+	Data         TenantUsageData `json:"data"`
+}
+
+// TenantUsageData represents the storage usage of the tenant account.
+type TenantUsageData struct {
+	CalculationTime  string            `json:"calculationTime"`
+	ObjectCount      int64             `json:"objectCount"`
+	DataBytes        int64             `json:"dataBytes"`
+	QuotaObjectBytes *int64            `json:"quotaObjectBytes,omitempty"`
+	Buckets          []BucketUsageData `json:"buckets"`
+}
+
+// BucketUsageData represents the storage usage of a single bucket.
+type BucketUsageData struct {
+	Name        string `json:"name"`
+	ObjectCount int64  `json:"objectCount"`
+	DataBytes   int64  `json:"dataBytes"`
+}
+
+// QuotaUtilization returns the percentage of the tenant quota currently in use.
+// The second return value is false when no quota is configured for the tenant.
+func (u *TenantUsageData) QuotaUtilization() (float64, bool) {
+	if u.QuotaObjectBytes == nil || *u.QuotaObjectBytes <= 0 {
+		return 0, false
+	}
+
+	return float64(u.DataBytes) / float64(*u.QuotaObjectBytes) * 100, true
+}
+
+// GetTenantUsage retrieves the storage usage and quota of the tenant account.
+func (c *Client) GetTenantUsage(ctx context.Context) (*TenantUsageData, error) {
+	url := c.apiURL("/org/usage")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var apiResponse TenantUsageAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("error unmarshalling tenant usage response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// BucketUsage returns the usage of the named bucket or Swift container.
+// The second return value is false when the tenant usage does not include it.
+func (u *TenantUsageData) BucketUsage(name string) (*BucketUsageData, bool) {
+	for i := range u.Buckets {
+		if u.Buckets[i].Name == name {
+			return &u.Buckets[i], true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTenantUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("method = %s, want %s", r.Method, http.MethodGet)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path != "/api/v4/org/usage" {
+			t.Errorf("path = %s, want /api/v4/org/usage", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want Bearer test-token", got)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"status": "success",
+			"apiVersion": "4.0",
+			"data": {
+				"calculationTime": "2026-01-01T00:00:00.000Z",
+				"objectCount": 12,
+				"dataBytes": 900,
+				"quotaObjectBytes": 1000,
+				"buckets": [
+					{"name": "logs", "objectCount": 12, "dataBytes": 900}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	usage, err := client.GetTenantUsage(context.Background())
+	if err != nil {
+		t.Fatalf("GetTenantUsage returned error: %v", err)
+	}
+	if usage.DataBytes != 900 || usage.ObjectCount != 12 {
+		t.Fatalf("usage = %#v", usage)
+	}
+	if len(usage.Buckets) != 1 || usage.Buckets[0].Name != "logs" {
+		t.Fatalf("buckets = %#v", usage.Buckets)
+	}
+
+	utilization, ok := usage.QuotaUtilization()
+	if !ok {
+		t.Fatal("QuotaUtilization reported no quota")
+	}
+	if utilization != 90 {
+		t.Fatalf("utilization = %v, want 90", utilization)
+	}
+}
+
+func TestTenantUsageQuotaUtilization(t *testing.T) {
+	zero := int64(0)
+	quota := int64(400)
+
+	tests := []struct {
+		name    string
+		usage   TenantUsageData
+		want    float64
+		wantSet bool
+	}{
+		{
+			name:  "no quota",
+			usage: TenantUsageData{DataBytes: 100},
+		},
+		{
+			name:  "zero quota",
+			usage: TenantUsageData{DataBytes: 100, QuotaObjectBytes: &zero},
+		},
+		{
+			name:    "quota set",
+			usage:   TenantUsageData{DataBytes: 100, QuotaObjectBytes: &quota},
+			want:    25,
+			wantSet: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.usage.QuotaUtilization()
+			if ok != tt.wantSet || got != tt.want {
+				t.Fatalf("QuotaUtilization() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantSet)
+			}
+		})
+	}
+}
+
+func TestTenantUsageBucketUsage(t *testing.T) {
+	usage := TenantUsageData{
+		Buckets: []BucketUsageData{
+			{Name: "logs", ObjectCount: 3, DataBytes: 30},
+			{Name: "backups", ObjectCount: 0, DataBytes: 0},
+		},
+	}
+
+	bucket, ok := usage.BucketUsage("logs")
+	if !ok {
+		t.Fatal("BucketUsage(logs) reported not found")
+	}
+	if bucket.ObjectCount != 3 || bucket.DataBytes != 30 {
+		t.Fatalf("BucketUsage(logs) = %#v", bucket)
+	}
+
+	if _, ok := usage.BucketUsage("missing"); ok {
+		t.Fatal("BucketUsage(missing) reported found")
+	}
+}
@@ -5,12 +5,17 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
+// userListPageSize is the number of users requested per page when listing users.
+const userListPageSize = 100
+
 // UserAPIResponse represents the full API response for a single user.
 type UserAPIResponse struct {
 	ResponseTime string   `json:"responseTime"`
@@ -19,6 +24,14 @@ type UserAPIResponse struct {
 	Data         UserData `json:"data"`
 }
 
+// UserListAPIResponse represents a page of the user list.
+type UserListAPIResponse struct {
+	ResponseTime string     `json:"responseTime"`
+	Status       string     `json:"status"`
+	APIVersion   string     `json:"apiVersion"`
+	Data         []UserData `json:"data"`
+}
+
 // UserData represents the detailed information about a single user.
 type UserData struct {
 	ID         string   `json:"id"`
@@ -44,11 +57,50 @@ type ChangePasswordPayload struct {
 	Password string `json:"password"`
 }
 
-func (c *Client) GetUser(id string) (*UserAPIResponse, error) {
-	url := fmt.Sprintf("%s/api/v4/org/users/%s", c.EndpointURL, id)
-	log.Printf("Executing GET request to URL: %s", url)
+// ListUsers retrieves all users of the tenant account, paging through the user list.
+func (c *Client) ListUsers(ctx context.Context) ([]UserData, error) {
+	var users []UserData
+	marker := ""
+	for {
+		reqUrl, err := url.Parse(c.apiURL("/org/users"))
+		if err != nil {
+			return nil, fmt.Errorf("error creating request url: %w", err)
+		}
+		queryParams := reqUrl.Query()
+		queryParams.Set("limit", strconv.Itoa(userListPageSize))
+		// The marker is the URN of the last user of the previous page
+		if marker != "" {
+			queryParams.Set("marker", marker)
+		}
+		reqUrl.RawQuery = queryParams.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, "GET", reqUrl.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating GET request: %w", err)
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var page UserListAPIResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("error unmarshaling user list response: %w", err)
+		}
+
+		users = append(users, page.Data...)
+		if len(page.Data) < userListPageSize {
+			return users, nil
+		}
+		marker = page.Data[len(page.Data)-1].UserURN
+	}
+}
+
+func (c *Client) GetUser(ctx context.Context, id string) (*UserAPIResponse, error) {
+	url := c.apiURL("/org/users/%s", id)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating GET request: %w", err)
 	}
@@ -67,16 +119,15 @@ func (c *Client) GetUser(id string) (*UserAPIResponse, error) {
 	return &userResponse, nil
 }
 
-func (c *Client) CreateUser(payload UserPayload) (*UserAPIResponse, error) {
+func (c *Client) CreateUser(ctx context.Context, payload UserPayload) (*UserAPIResponse, error) {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling create user payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/api/v4/org/users", c.EndpointURL)
-	log.Printf("Executing POST request to URL: %s with payload %s", url, string(payloadBytes))
+	url := c.apiURL("/org/users")
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, fmt.Errorf("error creating create user request: %w", err)
 	}
@@ -95,16 +146,15 @@ func (c *Client) CreateUser(payload UserPayload) (*UserAPIResponse, error) {
 	return &createdUser, nil
 }
 
-func (c *Client) UpdateUser(id string, payload UserPayload) (*UserAPIResponse, error) {
+func (c *Client) UpdateUser(ctx context.Context, id string, payload UserPayload) (*UserAPIResponse, error) {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling update user payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/api/v4/org/users/%s", c.EndpointURL, id)
-	log.Printf("Executing PUT request to URL: %s with payload %s", url, string(payloadBytes))
+	url := c.apiURL("/org/users/%s", id)
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, fmt.Errorf("error creating update user request: %w", err)
 	}
@@ -123,26 +173,18 @@ func (c *Client) UpdateUser(id string, payload UserPayload) (*UserAPIResponse, e
 	return &updatedUser, nil
 }
 
-func (c *Client) DeleteUser(id string) error {
-	url := fmt.Sprintf("%s/api/v4/org/users/%s", c.EndpointURL, id)
-	log.Printf("Executing DELETE request to URL: %s", url)
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return fmt.Errorf("error creating DELETE request: %w", err)
-	}
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	url := c.apiURL("/org/users/%s", id)
 
-	_, err = c.doRequest(req)
-	if err != nil {
-		return fmt.Errorf("error executing DELETE request: %w", err)
-	}
-
-	return nil
+	return c.deleteAndVerify(ctx, url, func(ctx context.Context) error {
+		_, err := c.GetUser(ctx, id)
+		return err
+	})
 }
 
 // ChangeUserPassword updates the password for a local tenant user.
 // The shortName parameter should be the user's unique name (e.g., "user/username").
-func (c *Client) ChangeUserPassword(shortName string, password string) error {
+func (c *Client) ChangeUserPassword(ctx context.Context, shortName string, password string) error {
 	payload := ChangePasswordPayload{
 		Password: password,
 	}
@@ -152,10 +194,9 @@ func (c *Client) ChangeUserPassword(shortName string, password string) error {
 		return fmt.Errorf("error marshaling change password payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/api/v4/org/users/%s/change-password", c.EndpointURL, shortName)
-	log.Printf("Executing POST request to URL: %s", url)
+	url := c.apiURL("/org/users/%s/change-password", shortName)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return fmt.Errorf("error creating change password request: %w", err)
 	}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestListUsersPagesThroughUsers(t *testing.T) {
+	var all []UserData
+	for i := range 250 {
+		all = append(all, UserData{ID: fmt.Sprint(i), UniqueName: fmt.Sprintf("user/u%03d", i), UserURN: fmt.Sprintf("urn:sgws:identity::1:user/u%03d", i)})
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("limit") != fmt.Sprint(userListPageSize) {
+			t.Errorf("limit = %q, want %d", r.URL.Query().Get("limit"), userListPageSize)
+		}
+		start := 0
+		if marker := r.URL.Query().Get("marker"); marker != "" {
+			start = slices.IndexFunc(all, func(u UserData) bool { return u.UserURN == marker }) + 1
+		}
+		end := min(start+userListPageSize, len(all))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(UserListAPIResponse{Status: "success", Data: all[start:end]})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		EndpointURL: server.URL,
+		HTTPClient:  server.Client(),
+		Token:       "test-token",
+	}
+
+	users, err := client.ListUsers(context.Background())
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if len(users) != len(all) || users[249].ID != "249" {
+		t.Fatalf("ListUsers returned %d users, want %d", len(users), len(all))
+	}
+	if requests != 3 {
+		t.Fatalf("server received %d requests, want 3 pages", requests)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/provider"
 	"github.com/team-fenrir/terraform-provider-storagegrid/internal/utils"
 )
@@ -23,14 +25,31 @@ var (
 )
 
 func main() {
-	var debug bool
+	var debug, check bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&check, "check", false, "sign in using the STORAGEGRID_* environment variables, print a connectivity and permission report and exit")
 	flag.Parse()
 
+	if check {
+		if !runCheck(context.Background(), os.Stdout) {
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Ensure temporary S3 access keys are cleaned up when provider exits
 	defer utils.CleanupActiveClient()
 
+	// Log the API request metrics at debug level when provider exits, with a logger
+	// writing to the plugin output as the loggers of the provider requests do
+	logCtx := tfsdklog.NewRootProviderLogger(context.Background(),
+		tfsdklog.WithLogName("storagegrid"),
+		tfsdklog.WithLevelFromEnv("TF_LOG_PROVIDER"),
+		tfsdklog.WithStderrFromInit(),
+	)
+	defer utils.LogActiveClientMetrics(logCtx)
+
 	opts := providerserver.ServeOpts{
 		// TODO: Update this string with the published name of your provider.
 		// Also update the tfplugindocs generate command to either remove the